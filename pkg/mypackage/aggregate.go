@@ -0,0 +1,107 @@
+package mypackage
+
+import "context"
+
+// sumCtxCheckInterval is how many values SumCtx adds between checks of its
+// context.
+const sumCtxCheckInterval = 1024
+
+// Sum returns the sum of all values, or the zero value for an empty slice.
+// For integer types the sum wraps on overflow, just like the + operator.
+func Sum[T Number](values []T) T {
+	var total T
+	for _, v := range values {
+		total += v
+	}
+	return total
+}
+
+// CumulativeSum returns the running totals of values: element i of the
+// result is the sum of values[0] through values[i]. For integer types each
+// total wraps on overflow, just like the + operator. The caller's slice is
+// not modified, and an empty input yields an empty, non-nil slice.
+func CumulativeSum[T Number](values []T) []T {
+	result := make([]T, len(values))
+	var total T
+	for i, v := range values {
+		total += v
+		result[i] = total
+	}
+	return result
+}
+
+// SumCtx is like Sum, but checks ctx before the first value and after every
+// sumCtxCheckInterval values, so summing a very large slice can be abandoned.
+// If ctx is cancelled before the sum completes, SumCtx returns the zero
+// value and ctx.Err().
+func SumCtx[T Number](ctx context.Context, values []T) (T, error) {
+	var total T
+	for i, v := range values {
+		if i%sumCtxCheckInterval == 0 {
+			if err := ctx.Err(); err != nil {
+				var zero T
+				return zero, err
+			}
+		}
+		total += v
+	}
+	return total, nil
+}
+
+// Product returns the product of all values, or 1 for an empty slice.
+// For integer types the product wraps on overflow, just like the * operator.
+func Product[T Number](values []T) T {
+	total := T(1)
+	for _, v := range values {
+		total *= v
+	}
+	return total
+}
+
+// SumKahan returns the sum of values using Kahan compensated summation,
+// which keeps the rounding error bounded independently of len(values).
+// Use it instead of Sum when adding many floats of very different magnitudes.
+func SumKahan(values []float64) float64 {
+	var total, compensation float64
+	for _, v := range values {
+		y := v - compensation
+		t := total + y
+		compensation = (t - total) - y
+		total = t
+	}
+	return total
+}
+
+// Reduce folds items into a single value by calling fn with the running
+// accumulator and each item in order, starting from initial.
+// For an empty or nil slice it returns initial.
+func Reduce[T, R any](items []T, initial R, fn func(acc R, item T) R) R {
+	acc := initial
+	for _, item := range items {
+		acc = fn(acc, item)
+	}
+	return acc
+}
+
+// AddMany returns the sum of all arguments, or 0 when called with none.
+// Like Add, it wraps on overflow; use AddChecked to detect overflow.
+func AddMany(values ...int) int {
+	return Sum(values)
+}
+
+// AddManyFloat returns the sum of all arguments, or 0 when called with none.
+func AddManyFloat(values ...float64) float64 {
+	return Sum(values)
+}
+
+// MultiplyMany returns the product of all arguments, or 1 when called with
+// none. Like Multiply, it wraps on overflow.
+func MultiplyMany(values ...int) int {
+	return Product(values)
+}
+
+// MultiplyManyFloat returns the product of all arguments, or 1 when called
+// with none.
+func MultiplyManyFloat(values ...float64) float64 {
+	return Product(values)
+}
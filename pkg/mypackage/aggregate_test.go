@@ -0,0 +1,365 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestSum(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			values   []int
+			expected int
+		}{
+			{"empty slice", []int{}, 0},
+			{"nil slice", nil, 0},
+			{"single value", []int{7}, 7},
+			{"positive values", []int{1, 2, 3, 4}, 10},
+			{"mixed signs", []int{5, -3, 2, -10}, -6},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result := Sum(tt.values)
+				if result != tt.expected {
+					t.Errorf("Sum(%v) = %d; want %d", tt.values, result, tt.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("int64", func(t *testing.T) {
+		values := []int64{1 << 40, -(1 << 39), 5}
+		expected := int64(1<<40 - 1<<39 + 5)
+		if result := Sum(values); result != expected {
+			t.Errorf("Sum(%v) = %d; want %d", values, result, expected)
+		}
+		if result := Sum([]int64{}); result != 0 {
+			t.Errorf("Sum([]int64{}) = %d; want 0", result)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{1.5, -2.5, 4.0}
+		if result := Sum(values); result != 3.0 {
+			t.Errorf("Sum(%v) = %f; want 3.0", values, result)
+		}
+		if result := Sum([]float64{}); result != 0 {
+			t.Errorf("Sum([]float64{}) = %f; want 0", result)
+		}
+	})
+}
+
+// cancelAfterContext reports itself cancelled once Err has been called more
+// than checks times, so tests can cancel at a deterministic point.
+type cancelAfterContext struct {
+	context.Context
+	checks int
+}
+
+func (c *cancelAfterContext) Err() error {
+	if c.checks <= 0 {
+		return context.Canceled
+	}
+	c.checks--
+	return nil
+}
+
+func TestSumCtx(t *testing.T) {
+	values := make([]int, 10*sumCtxCheckInterval+3)
+	for i := range values {
+		values[i] = i - 5000
+	}
+
+	t.Run("matches Sum", func(t *testing.T) {
+		for _, v := range [][]int{nil, {}, {4, -2, 9}, values} {
+			result, err := SumCtx(context.Background(), v)
+			if err != nil {
+				t.Fatalf("SumCtx(%d values) returned error: %v", len(v), err)
+			}
+			if expected := Sum(v); result != expected {
+				t.Errorf("SumCtx(%d values) = %d; want %d", len(v), result, expected)
+			}
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		floats := []float64{1.5, -2.5, 4.0}
+		if result, err := SumCtx(context.Background(), floats); err != nil || result != 3 {
+			t.Errorf("SumCtx(%v) = %v, %v; want 3, nil", floats, result, err)
+		}
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := SumCtx(ctx, []int{1, 2, 3}); !errors.Is(err, context.Canceled) {
+			t.Errorf("SumCtx(cancelled) error = %v; want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("cancelled partway through", func(t *testing.T) {
+		ctx := &cancelAfterContext{Context: context.Background(), checks: 3}
+		result, err := SumCtx(ctx, values)
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("SumCtx() error = %v; want %v", err, context.Canceled)
+		}
+		if result != 0 {
+			t.Errorf("SumCtx() = %d after cancellation; want 0", result)
+		}
+	})
+}
+
+func TestCumulativeSum(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"running totals", []int{1, 2, 3, 4}, []int{1, 3, 6, 10}},
+		{"mixed signs", []int{5, -3, 2, -10}, []int{5, 2, 4, -6}},
+		{"single value", []int{7}, []int{7}},
+		{"empty slice", []int{}, []int{}},
+		{"nil slice", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := CumulativeSum(tt.values)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("CumulativeSum(%v) = %#v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{0.5, 1.5, -1}
+		if result, expected := CumulativeSum(values), []float64{0.5, 2, 1}; !equalSlices(result, expected) {
+			t.Errorf("CumulativeSum(%v) = %v; want %v", values, result, expected)
+		}
+	})
+
+	t.Run("does not modify input", func(t *testing.T) {
+		values := []int{1, 2, 3}
+		CumulativeSum(values)
+		if expected := []int{1, 2, 3}; !equalSlices(values, expected) {
+			t.Errorf("CumulativeSum() mutated input: got %v; want %v", values, expected)
+		}
+	})
+
+	t.Run("wraps on overflow", func(t *testing.T) {
+		result := CumulativeSum([]int8{100, 100})
+		if expected := []int8{100, -56}; !equalSlices(result, expected) {
+			t.Errorf("CumulativeSum([100 100]) = %v; want %v", result, expected)
+		}
+	})
+}
+
+func TestProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+	}{
+		{"empty slice", []int{}, 1},
+		{"nil slice", nil, 1},
+		{"single value", []int{7}, 7},
+		{"positive values", []int{1, 2, 3, 4}, 24},
+		{"one negative value", []int{2, -3, 4}, -24},
+		{"two negative values", []int{-2, -3, 4}, 24},
+		{"containing zero", []int{5, 0, 9}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Product(tt.values)
+			if result != tt.expected {
+				t.Errorf("Product(%v) = %d; want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{0.5, -4, 1.5}
+		if result := Product(values); result != -3 {
+			t.Errorf("Product(%v) = %f; want -3", values, result)
+		}
+	})
+}
+
+func TestSumKahan(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"empty slice", []float64{}, 0},
+		{"nil slice", nil, 0},
+		{"single value", []float64{2.5}, 2.5},
+		{"mixed signs", []float64{1.5, -2.5, 4.0}, 3.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := SumKahan(tt.values); result != tt.expected {
+				t.Errorf("SumKahan(%v) = %v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("large value plus many tiny values", func(t *testing.T) {
+		const n = 1_000_000
+		values := make([]float64, 0, n+1)
+		values = append(values, 1)
+		for i := 0; i < n; i++ {
+			values = append(values, 1e-16)
+		}
+		expected := 1 + n*1e-16
+
+		naiveErr := math.Abs(Sum(values) - expected)
+		kahanErr := math.Abs(SumKahan(values) - expected)
+		if kahanErr >= naiveErr {
+			t.Fatalf("SumKahan error %v is not smaller than Sum error %v", kahanErr, naiveErr)
+		}
+		if kahanErr > 1e-15 {
+			t.Errorf("SumKahan(...) = %v; want %v", SumKahan(values), expected)
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	values := []int{3, 1, 4, 1, 5}
+
+	t.Run("sum", func(t *testing.T) {
+		result := Reduce(values, 0, func(acc, v int) int { return acc + v })
+		if result != 14 {
+			t.Errorf("Reduce(%v, 0, add) = %d; want 14", values, result)
+		}
+	})
+
+	t.Run("product", func(t *testing.T) {
+		result := Reduce(values, 1, func(acc, v int) int { return acc * v })
+		if result != 60 {
+			t.Errorf("Reduce(%v, 1, multiply) = %d; want 60", values, result)
+		}
+	})
+
+	t.Run("max", func(t *testing.T) {
+		result := Reduce(values, math.MinInt, func(acc, v int) int { return max(acc, v) })
+		if result != 5 {
+			t.Errorf("Reduce(%v, MinInt, max) = %d; want 5", values, result)
+		}
+	})
+
+	t.Run("different accumulator type", func(t *testing.T) {
+		result := Reduce([]string{"a", "bb", "ccc"}, 0, func(acc int, s string) int { return acc + len(s) })
+		if result != 6 {
+			t.Errorf("Reduce(strings, 0, addLen) = %d; want 6", result)
+		}
+	})
+
+	t.Run("empty returns initial", func(t *testing.T) {
+		called := false
+		result := Reduce([]int{}, 42, func(acc, v int) int { called = true; return acc + v })
+		if result != 42 || called {
+			t.Errorf("Reduce([], 42, add) = %d (fn called: %v); want 42 without calls", result, called)
+		}
+		if result := Reduce[int, int](nil, 7, func(acc, v int) int { return acc + v }); result != 7 {
+			t.Errorf("Reduce(nil, 7, add) = %d; want 7", result)
+		}
+	})
+}
+
+func TestAddMany(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+	}{
+		{"no arguments", nil, 0},
+		{"one argument", []int{7}, 7},
+		{"several arguments", []int{1, 2, 3, 4}, 10},
+		{"with negatives", []int{10, -3, -8, 2}, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := AddMany(tt.values...); result != tt.expected {
+				t.Errorf("AddMany(%v) = %d; want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("wraps on overflow", func(t *testing.T) {
+		if result := AddMany(math.MaxInt, 1); result != math.MinInt {
+			t.Errorf("AddMany(MaxInt, 1) = %d; want %d", result, math.MinInt)
+		}
+	})
+}
+
+func TestAddManyFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"no arguments", nil, 0},
+		{"one argument", []float64{2.5}, 2.5},
+		{"several arguments", []float64{0.5, 1.25, 2}, 3.75},
+		{"with negatives", []float64{1.5, -4, 0.5}, -2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := AddManyFloat(tt.values...); result != tt.expected {
+				t.Errorf("AddManyFloat(%v) = %v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMultiplyMany(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+	}{
+		{"no arguments", nil, 1},
+		{"one argument", []int{7}, 7},
+		{"several arguments", []int{2, 3, 4}, 24},
+		{"with negatives", []int{-2, 3, -5}, 30},
+		{"zero operand", []int{9, 0, 12}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := MultiplyMany(tt.values...); result != tt.expected {
+				t.Errorf("MultiplyMany(%v) = %d; want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMultiplyManyFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected float64
+	}{
+		{"no arguments", nil, 1},
+		{"one argument", []float64{2.5}, 2.5},
+		{"several arguments", []float64{0.5, 4, 1.5}, 3},
+		{"zero operand", []float64{3.5, 0, 8}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := MultiplyManyFloat(tt.values...); result != tt.expected {
+				t.Errorf("MultiplyManyFloat(%v) = %v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package mypackage
+
+import "math"
+
+// DegreesToRadians converts an angle from degrees to radians.
+// Dividing by 180 before multiplying by Pi keeps common angles exact,
+// so DegreesToRadians(180) is exactly math.Pi.
+func DegreesToRadians(deg float64) float64 {
+	return deg / 180 * math.Pi
+}
+
+// RadiansToDegrees converts an angle from radians to degrees.
+// RadiansToDegrees(math.Pi) is exactly 180.
+func RadiansToDegrees(rad float64) float64 {
+	return rad / math.Pi * 180
+}
@@ -0,0 +1,55 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDegreesToRadians(t *testing.T) {
+	tests := []struct {
+		deg      float64
+		expected float64
+	}{
+		{0, 0},
+		{45, math.Pi / 4},
+		{90, math.Pi / 2},
+		{180, math.Pi},
+		{-180, -math.Pi},
+		{360, 2 * math.Pi},
+	}
+
+	for _, tt := range tests {
+		if result := DegreesToRadians(tt.deg); result != tt.expected {
+			t.Errorf("DegreesToRadians(%v) = %v; want %v", tt.deg, result, tt.expected)
+		}
+	}
+}
+
+func TestRadiansToDegrees(t *testing.T) {
+	tests := []struct {
+		rad      float64
+		expected float64
+	}{
+		{0, 0},
+		{math.Pi / 4, 45},
+		{math.Pi / 2, 90},
+		{math.Pi, 180},
+		{-math.Pi, -180},
+		{2 * math.Pi, 360},
+	}
+
+	for _, tt := range tests {
+		if result := RadiansToDegrees(tt.rad); result != tt.expected {
+			t.Errorf("RadiansToDegrees(%v) = %v; want %v", tt.rad, result, tt.expected)
+		}
+	}
+}
+
+func TestAngleRoundTrip(t *testing.T) {
+	for _, deg := range []float64{0, 1, 30, 60, 123.456, -270, 720, 1e6} {
+		result := RadiansToDegrees(DegreesToRadians(deg))
+		if math.Abs(result-deg) > 1e-9*math.Max(1, math.Abs(deg)) {
+			t.Errorf("RadiansToDegrees(DegreesToRadians(%v)) = %v", deg, result)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package mypackage
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// AddBig returns a + b as a newly allocated big.Int.
+// Neither argument is modified, and a nil argument is treated as zero.
+func AddBig(a, b *big.Int) *big.Int {
+	return new(big.Int).Add(bigOrZero(a), bigOrZero(b))
+}
+
+// MultiplyBig returns a * b as a newly allocated big.Int.
+// Neither argument is modified, and a nil argument is treated as zero.
+func MultiplyBig(a, b *big.Int) *big.Int {
+	return new(big.Int).Mul(bigOrZero(a), bigOrZero(b))
+}
+
+// BigFromInt returns n as a newly allocated big.Int.
+func BigFromInt(n int) *big.Int {
+	return big.NewInt(int64(n))
+}
+
+// ParseBig parses a base-10 integer of arbitrary size, with an optional
+// leading sign. It returns an error wrapping ErrInvalidNumber if s is not
+// a valid integer.
+func ParseBig(s string) (*big.Int, error) {
+	n, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	}
+	return n, nil
+}
+
+// AddRat returns a + b as a newly allocated big.Rat.
+// Neither argument is modified, and a nil argument is treated as zero.
+func AddRat(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Add(ratOrZero(a), ratOrZero(b))
+}
+
+// MultiplyRat returns a * b as a newly allocated big.Rat.
+// Neither argument is modified, and a nil argument is treated as zero.
+func MultiplyRat(a, b *big.Rat) *big.Rat {
+	return new(big.Rat).Mul(ratOrZero(a), ratOrZero(b))
+}
+
+// ParseRat parses an exact rational number written as a fraction such as
+// "3/4" or as a decimal such as "-1.25". It returns an error wrapping
+// ErrInvalidNumber if s is malformed or has a zero denominator.
+func ParseRat(s string) (*big.Rat, error) {
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	}
+	return r, nil
+}
+
+// bigOrZero returns n, or a zero big.Int if n is nil.
+func bigOrZero(n *big.Int) *big.Int {
+	if n == nil {
+		return new(big.Int)
+	}
+	return n
+}
+
+// ratOrZero returns r, or a zero big.Rat if r is nil.
+func ratOrZero(r *big.Rat) *big.Rat {
+	if r == nil {
+		return new(big.Rat)
+	}
+	return r
+}
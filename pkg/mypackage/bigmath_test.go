@@ -0,0 +1,191 @@
+package mypackage
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+// mustParseBig parses s or fails the test.
+func mustParseBig(t *testing.T, s string) *big.Int {
+	t.Helper()
+	n, err := ParseBig(s)
+	if err != nil {
+		t.Fatalf("ParseBig(%q) returned error: %v", s, err)
+	}
+	return n
+}
+
+func TestAddBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"small values", "2", "3", "5"},
+		{"beyond int64", "9223372036854775807", "1", "9223372036854775808"},
+		{"very large", "123456789012345678901234567890", "987654321098765432109876543210", "1111111110111111111011111111100"},
+		{"negative", "-100000000000000000000", "1", "-99999999999999999999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParseBig(t, tt.a), mustParseBig(t, tt.b)
+			result := AddBig(a, b)
+			if result.String() != tt.expected {
+				t.Errorf("AddBig(%s, %s) = %s; want %s", tt.a, tt.b, result, tt.expected)
+			}
+			if a.String() != tt.a || b.String() != tt.b {
+				t.Errorf("AddBig() mutated its inputs: got %s, %s", a, b)
+			}
+		})
+	}
+
+	t.Run("nil is zero", func(t *testing.T) {
+		if result := AddBig(nil, big.NewInt(7)); result.Int64() != 7 {
+			t.Errorf("AddBig(nil, 7) = %s; want 7", result)
+		}
+		if result := AddBig(nil, nil); result.Sign() != 0 {
+			t.Errorf("AddBig(nil, nil) = %s; want 0", result)
+		}
+	})
+}
+
+func TestMultiplyBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"small values", "6", "7", "42"},
+		{"beyond int64", "9223372036854775807", "2", "18446744073709551614"},
+		{"very large", "123456789012345678901234567890", "1000000000000", "123456789012345678901234567890000000000000"},
+		{"negative", "-4294967296", "4294967296", "-18446744073709551616"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParseBig(t, tt.a), mustParseBig(t, tt.b)
+			result := MultiplyBig(a, b)
+			if result.String() != tt.expected {
+				t.Errorf("MultiplyBig(%s, %s) = %s; want %s", tt.a, tt.b, result, tt.expected)
+			}
+			if a.String() != tt.a || b.String() != tt.b {
+				t.Errorf("MultiplyBig() mutated its inputs: got %s, %s", a, b)
+			}
+		})
+	}
+
+	t.Run("nil is zero", func(t *testing.T) {
+		if result := MultiplyBig(big.NewInt(7), nil); result.Sign() != 0 {
+			t.Errorf("MultiplyBig(7, nil) = %s; want 0", result)
+		}
+	})
+
+	t.Run("result is a fresh value", func(t *testing.T) {
+		a := big.NewInt(3)
+		result := MultiplyBig(a, big.NewInt(1))
+		result.SetInt64(100)
+		if a.Int64() != 3 {
+			t.Errorf("MultiplyBig() result aliases its input")
+		}
+	})
+}
+
+func TestBigFromInt(t *testing.T) {
+	if n := BigFromInt(-42); n.String() != "-42" {
+		t.Errorf("BigFromInt(-42) = %s; want -42", n)
+	}
+}
+
+func TestParseBig(t *testing.T) {
+	if n := mustParseBig(t, "+18446744073709551616"); n.String() != "18446744073709551616" {
+		t.Errorf("ParseBig(+18446744073709551616) = %s", n)
+	}
+
+	for _, input := range []string{"", "abc", "12.5", "1e10", "0x10", " 1"} {
+		if _, err := ParseBig(input); !errors.Is(err, ErrInvalidNumber) {
+			t.Errorf("ParseBig(%q) error = %v; want %v", input, err, ErrInvalidNumber)
+		}
+	}
+}
+
+// mustParseRat parses s or fails the test.
+func mustParseRat(t *testing.T, s string) *big.Rat {
+	t.Helper()
+	r, err := ParseRat(s)
+	if err != nil {
+		t.Fatalf("ParseRat(%q) returned error: %v", s, err)
+	}
+	return r
+}
+
+func TestAddRat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"thirds and sixths", "1/3", "1/6", "1/2"},
+		{"decimals", "0.1", "0.2", "3/10"},
+		{"mixed forms", "1/4", "0.75", "1/1"},
+		{"negative", "-2/3", "1/3", "-1/3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := mustParseRat(t, tt.a), mustParseRat(t, tt.b)
+			before := a.String()
+			result := AddRat(a, b)
+			if result.Cmp(mustParseRat(t, tt.expected)) != 0 {
+				t.Errorf("AddRat(%s, %s) = %s; want %s", tt.a, tt.b, result, tt.expected)
+			}
+			if a.String() != before {
+				t.Errorf("AddRat() mutated its input: got %s; want %s", a, before)
+			}
+		})
+	}
+
+	t.Run("nil is zero", func(t *testing.T) {
+		if result := AddRat(nil, big.NewRat(1, 2)); result.Cmp(big.NewRat(1, 2)) != 0 {
+			t.Errorf("AddRat(nil, 1/2) = %s; want 1/2", result)
+		}
+	})
+}
+
+func TestMultiplyRat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     string
+		expected string
+	}{
+		{"fractions", "2/3", "3/4", "1/2"},
+		{"decimal and fraction", "0.5", "2/7", "1/7"},
+		{"negative", "-1/3", "3", "-1"},
+		{"by zero", "5/8", "0", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MultiplyRat(mustParseRat(t, tt.a), mustParseRat(t, tt.b))
+			if result.Cmp(mustParseRat(t, tt.expected)) != 0 {
+				t.Errorf("MultiplyRat(%s, %s) = %s; want %s", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseRat(t *testing.T) {
+	if r := mustParseRat(t, "6/8"); r.String() != "3/4" {
+		t.Errorf("ParseRat(6/8) = %s; want 3/4", r)
+	}
+	if r := mustParseRat(t, "-1.25"); r.String() != "-5/4" {
+		t.Errorf("ParseRat(-1.25) = %s; want -5/4", r)
+	}
+
+	for _, input := range []string{"", "abc", "1/0", "1//2", "1/2/3", "1.2.3", "/2"} {
+		if _, err := ParseRat(input); !errors.Is(err, ErrInvalidNumber) {
+			t.Errorf("ParseRat(%q) error = %v; want %v", input, err, ErrInvalidNumber)
+		}
+	}
+}
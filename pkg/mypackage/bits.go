@@ -0,0 +1,52 @@
+package mypackage
+
+import "math/bits"
+
+// PopCount returns the number of set bits in n.
+func PopCount(n uint64) int {
+	return bits.OnesCount64(n)
+}
+
+// LeadingZeros returns the number of leading zero bits in n.
+// LeadingZeros(0) is 64 rather than an error, matching math/bits.
+func LeadingZeros(n uint64) int {
+	return bits.LeadingZeros64(n)
+}
+
+// TrailingZeros returns the number of trailing zero bits in n.
+// TrailingZeros(0) is 64 rather than an error, matching math/bits.
+func TrailingZeros(n uint64) int {
+	return bits.TrailingZeros64(n)
+}
+
+// IsPowerOfTwo reports whether n is a power of two.
+// Zero is not a power of two.
+func IsPowerOfTwo(n uint64) bool {
+	return n != 0 && n&(n-1) == 0
+}
+
+// ShiftLeft returns n shifted left by shift bits, that is n * 2^shift.
+// It returns ErrOverflow if any significant bit, including the sign, would
+// be lost. Shifting a non-zero n by the integer width or more always
+// overflows, while shifting zero by any amount returns zero.
+func ShiftLeft(n int, shift uint) (int, error) {
+	if n == 0 {
+		return 0, nil
+	}
+	if shift >= bits.UintSize {
+		return 0, &ArithmeticError{Op: "ShiftLeft", Err: ErrOverflow}
+	}
+	shifted := n << shift
+	if shifted>>shift != n {
+		return 0, &ArithmeticError{Op: "ShiftLeft", Err: ErrOverflow}
+	}
+	return shifted, nil
+}
+
+// ShiftRight returns n shifted right by shift bits using an arithmetic
+// shift, so the sign is preserved and the result rounds toward negative
+// infinity. When shift is at least the integer width, the result is 0 for
+// non-negative n and -1 for negative n.
+func ShiftRight(n int, shift uint) int {
+	return n >> shift
+}
@@ -0,0 +1,119 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestBitCounting(t *testing.T) {
+	tests := []struct {
+		name                   string
+		n                      uint64
+		pop, leading, trailing int
+	}{
+		{"zero", 0, 0, 64, 64},
+		{"one", 1, 1, 63, 0},
+		{"eight", 8, 1, 60, 3},
+		{"twelve", 12, 2, 60, 2},
+		{"0xFF", 0xFF, 8, 56, 0},
+		{"high bit", 1 << 63, 1, 0, 63},
+		{"max uint64", math.MaxUint64, 64, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := PopCount(tt.n); result != tt.pop {
+				t.Errorf("PopCount(%d) = %d; want %d", tt.n, result, tt.pop)
+			}
+			if result := LeadingZeros(tt.n); result != tt.leading {
+				t.Errorf("LeadingZeros(%d) = %d; want %d", tt.n, result, tt.leading)
+			}
+			if result := TrailingZeros(tt.n); result != tt.trailing {
+				t.Errorf("TrailingZeros(%d) = %d; want %d", tt.n, result, tt.trailing)
+			}
+		})
+	}
+}
+
+func TestIsPowerOfTwo(t *testing.T) {
+	tests := []struct {
+		n        uint64
+		expected bool
+	}{
+		{0, false},
+		{1, true},
+		{2, true},
+		{3, false},
+		{64, true},
+		{96, false},
+		{1 << 63, true},
+		{math.MaxUint64, false},
+	}
+
+	for _, tt := range tests {
+		if result := IsPowerOfTwo(tt.n); result != tt.expected {
+			t.Errorf("IsPowerOfTwo(%d) = %v; want %v", tt.n, result, tt.expected)
+		}
+	}
+}
+
+func TestShiftLeft(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		shift    uint
+		expected int
+		err      error
+	}{
+		{"no shift", 5, 0, 5, nil},
+		{"small shift", 3, 4, 48, nil},
+		{"negative value", -3, 2, -12, nil},
+		{"largest positive", 1, bits.UintSize - 2, 1 << (bits.UintSize - 2), nil},
+		{"min int", -1, bits.UintSize - 1, math.MinInt, nil},
+		{"into sign bit", 1, bits.UintSize - 1, 0, ErrOverflow},
+		{"loses high bits", math.MaxInt, 1, 0, ErrOverflow},
+		{"negative overflow", math.MinInt, 1, 0, ErrOverflow},
+		{"oversized shift", 1, bits.UintSize, 0, ErrOverflow},
+		{"huge shift", -1, 1000, 0, ErrOverflow},
+		{"zero with oversized shift", 0, 1000, 0, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ShiftLeft(tt.n, tt.shift)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ShiftLeft(%d, %d) error = %v; want %v", tt.n, tt.shift, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ShiftLeft(%d, %d) = %d; want %d", tt.n, tt.shift, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestShiftRight(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		shift    uint
+		expected int
+	}{
+		{"no shift", 5, 0, 5},
+		{"positive", 48, 4, 3},
+		{"truncates", 7, 1, 3},
+		{"negative rounds down", -7, 1, -4},
+		{"min int", math.MinInt, bits.UintSize - 1, -1},
+		{"oversized positive", math.MaxInt, bits.UintSize, 0},
+		{"oversized negative", -5, 1000, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := ShiftRight(tt.n, tt.shift); result != tt.expected {
+				t.Errorf("ShiftRight(%d, %d) = %d; want %d", tt.n, tt.shift, result, tt.expected)
+			}
+		})
+	}
+}
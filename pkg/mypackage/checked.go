@@ -0,0 +1,57 @@
+package mypackage
+
+import "math"
+
+// AddChecked returns the sum of two integers.
+// Unlike Add, it returns an *ArithmeticError wrapping ErrOverflow instead of
+// a wrapped result when the sum exceeds math.MaxInt or falls below
+// math.MinInt.
+func AddChecked(a, b int) (int, error) {
+	if (b > 0 && a > math.MaxInt-b) || (b < 0 && a < math.MinInt-b) {
+		return 0, &ArithmeticError{Op: "AddChecked", Err: ErrOverflow}
+	}
+	return a + b, nil
+}
+
+// multiplyChecked returns the product of two integers, or ErrOverflow if the
+// product cannot be represented as an int. The error is the bare sentinel;
+// exported callers wrap it in an *ArithmeticError naming themselves.
+func multiplyChecked(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	if (a == -1 && b == math.MinInt) || (b == -1 && a == math.MinInt) {
+		return 0, ErrOverflow
+	}
+	product := a * b
+	if product/b != a {
+		return 0, ErrOverflow
+	}
+	return product, nil
+}
+
+// AddSaturating returns the sum of two integers, saturating at
+// math.MaxInt or math.MinInt instead of wrapping on overflow.
+func AddSaturating(a, b int) int {
+	sum, err := AddChecked(a, b)
+	if err != nil {
+		if b > 0 {
+			return math.MaxInt
+		}
+		return math.MinInt
+	}
+	return sum
+}
+
+// MultiplySaturating returns the product of two integers, saturating at
+// math.MaxInt or math.MinInt instead of wrapping on overflow.
+func MultiplySaturating(a, b int) int {
+	product, err := multiplyChecked(a, b)
+	if err != nil {
+		if (a < 0) == (b < 0) {
+			return math.MaxInt
+		}
+		return math.MinInt
+	}
+	return product
+}
@@ -0,0 +1,100 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestAddChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		err      error
+	}{
+		{"positive numbers", 2, 3, 5, nil},
+		{"mixed signs", -2, 5, 3, nil},
+		{"max plus zero", math.MaxInt, 0, math.MaxInt, nil},
+		{"min plus zero", math.MinInt, 0, math.MinInt, nil},
+		{"max plus min", math.MaxInt, math.MinInt, -1, nil},
+		{"max plus negative", math.MaxInt, -1, math.MaxInt - 1, nil},
+		{"min plus positive", math.MinInt, 1, math.MinInt + 1, nil},
+		{"max plus one", math.MaxInt, 1, 0, ErrOverflow},
+		{"one plus max", 1, math.MaxInt, 0, ErrOverflow},
+		{"max plus max", math.MaxInt, math.MaxInt, 0, ErrOverflow},
+		{"min minus one", math.MinInt, -1, 0, ErrOverflow},
+		{"min plus min", math.MinInt, math.MinInt, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := AddChecked(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("AddChecked(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("AddChecked(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAddSaturating(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{"positive numbers", 2, 3, 5},
+		{"mixed signs", -2, 5, 3},
+		{"max plus min", math.MaxInt, math.MinInt, -1},
+		{"max plus one", math.MaxInt, 1, math.MaxInt},
+		{"max plus max", math.MaxInt, math.MaxInt, math.MaxInt},
+		{"min minus one", math.MinInt, -1, math.MinInt},
+		{"min plus min", math.MinInt, math.MinInt, math.MinInt},
+		{"at the boundary", math.MaxInt - 1, 1, math.MaxInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AddSaturating(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("AddSaturating(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMultiplySaturating(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{"positive numbers", 6, 7, 42},
+		{"mixed signs", -6, 7, -42},
+		{"with zero", math.MaxInt, 0, 0},
+		{"max times one", math.MaxInt, 1, math.MaxInt},
+		{"min times one", math.MinInt, 1, math.MinInt},
+		{"max times minus one", math.MaxInt, -1, -math.MaxInt},
+		{"max times two", math.MaxInt, 2, math.MaxInt},
+		{"max times minus two", math.MaxInt, -2, math.MinInt},
+		{"min times two", math.MinInt, 2, math.MinInt},
+		{"min times minus one", math.MinInt, -1, math.MaxInt},
+		{"minus one times min", -1, math.MinInt, math.MaxInt},
+		{"min times min", math.MinInt, math.MinInt, math.MaxInt},
+		{"large positives", 1 << (bits.UintSize / 2), 1 << (bits.UintSize / 2), math.MaxInt},
+		{"large opposite signs", 1 << (bits.UintSize / 2), -(1 << (bits.UintSize / 2)), math.MinInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := MultiplySaturating(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("MultiplySaturating(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
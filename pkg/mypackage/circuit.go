@@ -0,0 +1,145 @@
+package mypackage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// CircuitState is the state of a CircuitBreaker.
+type CircuitState int
+
+const (
+	// CircuitClosed lets every call through.
+	CircuitClosed CircuitState = iota
+	// CircuitOpen rejects every call with ErrCircuitOpen.
+	CircuitOpen
+	// CircuitHalfOpen lets a single trial call through to decide whether
+	// to close again or re-open.
+	CircuitHalfOpen
+)
+
+// String returns "closed", "open", or "half-open".
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// CircuitBreaker stops calling a failing operation for a while so it can
+// recover. After maxFailures consecutive failures it opens and rejects
+// calls; once resetTimeout has passed it half-opens and lets one trial call
+// through, closing again if the trial succeeds and re-opening if it fails.
+// It is safe for concurrent use.
+type CircuitBreaker struct {
+	mu           sync.Mutex
+	maxFailures  int
+	resetTimeout time.Duration
+	state        CircuitState
+	failures     int
+	trialRunning bool
+	// generation changes each time the breaker opens, so a reset timer
+	// left over from an earlier opening does nothing.
+	generation uint64
+}
+
+// NewCircuitBreaker returns a closed CircuitBreaker that opens after
+// maxFailures consecutive failures and half-opens resetTimeout later.
+// A maxFailures less than one is treated as one.
+func NewCircuitBreaker(maxFailures int, resetTimeout time.Duration) *CircuitBreaker {
+	if maxFailures < 1 {
+		maxFailures = 1
+	}
+	return &CircuitBreaker{maxFailures: maxFailures, resetTimeout: resetTimeout}
+}
+
+// Execute runs fn if the breaker allows it and records the outcome,
+// returning fn's error. It returns ErrCircuitOpen without running fn while
+// the breaker is open, or while it is half-open and a trial call is already
+// running, and ctx.Err() if ctx is already cancelled. The outcome of a call
+// that was admitted before the breaker last opened is not recorded. If fn
+// panics, the call counts as a failure and the panic propagates.
+func (b *CircuitBreaker) Execute(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	b.mu.Lock()
+	switch {
+	case b.state == CircuitOpen, b.state == CircuitHalfOpen && b.trialRunning:
+		b.mu.Unlock()
+		return ErrCircuitOpen
+	case b.state == CircuitHalfOpen:
+		b.trialRunning = true
+	}
+	// Decide at admission whether this call is the trial, and remember the
+	// generation so an outcome from before the latest trip can be ignored.
+	trial, generation := b.state == CircuitHalfOpen, b.generation
+	b.mu.Unlock()
+
+	// Record the outcome in a defer so that a panicking fn still counts as
+	// a failure; otherwise a panicking trial would leave the breaker stuck
+	// half-open with trialRunning set.
+	succeeded := false
+	defer func() { b.record(trial, generation, succeeded) }()
+
+	err := fn()
+	succeeded = err == nil
+	return err
+}
+
+// record updates the breaker with the outcome of a call admitted at the
+// given generation, ignoring it if the breaker has tripped since.
+func (b *CircuitBreaker) record(trial bool, generation uint64, succeeded bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.generation != generation {
+		// The breaker tripped while fn ran; this result is stale.
+		return
+	}
+	if trial {
+		b.trialRunning = false
+	}
+	if succeeded {
+		b.failures = 0
+		if trial {
+			b.state = CircuitClosed
+		}
+		return
+	}
+	b.failures++
+	if trial || (b.state == CircuitClosed && b.failures >= b.maxFailures) {
+		b.trip()
+	}
+}
+
+// State returns the breaker's current state.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// trip opens the breaker and schedules it to half-open after resetTimeout.
+// The caller must hold b.mu.
+func (b *CircuitBreaker) trip() {
+	b.state = CircuitOpen
+	b.generation++
+	generation := b.generation
+	go func() {
+		_ = Delay(context.Background(), b.resetTimeout)
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		if b.generation == generation && b.state == CircuitOpen {
+			b.state = CircuitHalfOpen
+			b.failures = 0
+		}
+	}()
+}
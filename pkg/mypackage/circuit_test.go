@@ -0,0 +1,226 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	succeed := func() error { return nil }
+	fail := func() error { return errFlaky }
+
+	t.Run("closed to open to half-open to closed", func(t *testing.T) {
+		b := NewCircuitBreaker(3, 30*time.Millisecond)
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			if state := b.State(); state != CircuitClosed {
+				t.Fatalf("State() = %v after %d failures; want closed", state, i)
+			}
+			if err := b.Execute(ctx, fail); !errors.Is(err, errFlaky) {
+				t.Fatalf("Execute() error = %v; want %v", err, errFlaky)
+			}
+		}
+		if state := b.State(); state != CircuitOpen {
+			t.Fatalf("State() = %v after 3 failures; want open", state)
+		}
+
+		calls := 0
+		if err := b.Execute(ctx, func() error { calls++; return nil }); !errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("Execute() while open error = %v; want %v", err, ErrCircuitOpen)
+		}
+		if calls != 0 {
+			t.Fatalf("Execute() ran fn %d times while open; want 0", calls)
+		}
+
+		time.Sleep(60 * time.Millisecond)
+		if state := b.State(); state != CircuitHalfOpen {
+			t.Fatalf("State() = %v after reset timeout; want half-open", state)
+		}
+
+		if err := b.Execute(ctx, succeed); err != nil {
+			t.Fatalf("Execute() trial call error = %v; want nil", err)
+		}
+		if state := b.State(); state != CircuitClosed {
+			t.Errorf("State() = %v after successful trial; want closed", state)
+		}
+	})
+
+	t.Run("failed trial re-opens", func(t *testing.T) {
+		b := NewCircuitBreaker(1, 20*time.Millisecond)
+		ctx := context.Background()
+
+		_ = b.Execute(ctx, fail)
+		time.Sleep(40 * time.Millisecond)
+		if state := b.State(); state != CircuitHalfOpen {
+			t.Fatalf("State() = %v after reset timeout; want half-open", state)
+		}
+
+		if err := b.Execute(ctx, fail); !errors.Is(err, errFlaky) {
+			t.Fatalf("Execute() trial call error = %v; want %v", err, errFlaky)
+		}
+		if state := b.State(); state != CircuitOpen {
+			t.Fatalf("State() = %v after failed trial; want open", state)
+		}
+		time.Sleep(40 * time.Millisecond)
+		if state := b.State(); state != CircuitHalfOpen {
+			t.Errorf("State() = %v after second reset timeout; want half-open", state)
+		}
+	})
+
+	t.Run("success resets the failure count", func(t *testing.T) {
+		b := NewCircuitBreaker(2, time.Minute)
+		ctx := context.Background()
+
+		for i := 0; i < 5; i++ {
+			_ = b.Execute(ctx, fail)
+			_ = b.Execute(ctx, succeed)
+		}
+		if state := b.State(); state != CircuitClosed {
+			t.Errorf("State() = %v with no consecutive failures; want closed", state)
+		}
+	})
+
+	t.Run("half-open allows a single trial", func(t *testing.T) {
+		b := NewCircuitBreaker(1, 10*time.Millisecond)
+		ctx := context.Background()
+
+		_ = b.Execute(ctx, fail)
+		time.Sleep(30 * time.Millisecond)
+
+		started, release := make(chan struct{}), make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- b.Execute(ctx, func() error {
+				close(started)
+				<-release
+				return nil
+			})
+		}()
+
+		<-started
+		if err := b.Execute(ctx, succeed); !errors.Is(err, ErrCircuitOpen) {
+			t.Errorf("Execute() alongside the trial call error = %v; want %v", err, ErrCircuitOpen)
+		}
+		close(release)
+		if err := <-done; err != nil {
+			t.Fatalf("Execute() trial call error = %v; want nil", err)
+		}
+		if state := b.State(); state != CircuitClosed {
+			t.Errorf("State() = %v after successful trial; want closed", state)
+		}
+	})
+
+	t.Run("call admitted while closed does not count as the trial", func(t *testing.T) {
+		for _, outcome := range []error{nil, errFlaky} {
+			b := NewCircuitBreaker(1, 20*time.Millisecond)
+			ctx := context.Background()
+
+			// A slow call admitted while closed, finishing after half-open.
+			slowStarted, slowRelease := make(chan struct{}), make(chan struct{})
+			slowDone := make(chan error)
+			go func() {
+				slowDone <- b.Execute(ctx, func() error {
+					close(slowStarted)
+					<-slowRelease
+					return outcome
+				})
+			}()
+			<-slowStarted
+
+			_ = b.Execute(ctx, fail)
+			time.Sleep(40 * time.Millisecond)
+			if state := b.State(); state != CircuitHalfOpen {
+				t.Fatalf("State() = %v after reset timeout; want half-open", state)
+			}
+
+			trialStarted, trialRelease := make(chan struct{}), make(chan struct{})
+			trialDone := make(chan error)
+			go func() {
+				trialDone <- b.Execute(ctx, func() error {
+					close(trialStarted)
+					<-trialRelease
+					return nil
+				})
+			}()
+			<-trialStarted
+
+			close(slowRelease)
+			<-slowDone
+			if state := b.State(); state != CircuitHalfOpen {
+				t.Errorf("stale outcome %v moved State() to %v; want half-open", outcome, state)
+			}
+			if err := b.Execute(ctx, succeed); !errors.Is(err, ErrCircuitOpen) {
+				t.Errorf("Execute() alongside the trial after stale outcome %v error = %v; want %v", outcome, err, ErrCircuitOpen)
+			}
+
+			close(trialRelease)
+			if err := <-trialDone; err != nil {
+				t.Fatalf("Execute() trial call error = %v; want nil", err)
+			}
+			if state := b.State(); state != CircuitClosed {
+				t.Errorf("State() = %v after successful trial; want closed", state)
+			}
+		}
+	})
+
+	t.Run("panicking trial re-opens", func(t *testing.T) {
+		b := NewCircuitBreaker(1, 20*time.Millisecond)
+		ctx := context.Background()
+
+		_ = b.Execute(ctx, fail)
+		time.Sleep(40 * time.Millisecond)
+		if state := b.State(); state != CircuitHalfOpen {
+			t.Fatalf("State() = %v after reset timeout; want half-open", state)
+		}
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("Execute() did not propagate the trial's panic")
+				}
+			}()
+			_ = b.Execute(ctx, func() error { panic("trial failed") })
+		}()
+		if state := b.State(); state != CircuitOpen {
+			t.Fatalf("State() = %v after panicking trial; want open", state)
+		}
+
+		time.Sleep(40 * time.Millisecond)
+		if err := b.Execute(ctx, succeed); err != nil {
+			t.Fatalf("Execute() after panicking trial error = %v; want nil", err)
+		}
+		if state := b.State(); state != CircuitClosed {
+			t.Errorf("State() = %v after successful trial; want closed", state)
+		}
+	})
+
+	t.Run("cancelled context", func(t *testing.T) {
+		b := NewCircuitBreaker(1, time.Minute)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if err := b.Execute(ctx, succeed); !errors.Is(err, context.Canceled) {
+			t.Errorf("Execute() error = %v; want %v", err, context.Canceled)
+		}
+	})
+}
+
+func TestCircuitStateString(t *testing.T) {
+	tests := []struct {
+		state    CircuitState
+		expected string
+	}{
+		{CircuitClosed, "closed"},
+		{CircuitOpen, "open"},
+		{CircuitHalfOpen, "half-open"},
+		{CircuitState(99), "unknown"},
+	}
+
+	for _, tt := range tests {
+		if result := tt.state.String(); result != tt.expected {
+			t.Errorf("CircuitState(%d).String() = %q; want %q", int(tt.state), result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package mypackage
+
+// isNaN reports whether v is a floating-point NaN.
+// It is always false for integer types.
+func isNaN[T Number](v T) bool {
+	return v != v
+}
+
+// Min returns the smallest of the given values.
+// It returns ErrEmptyInput if no values are given.
+// For floating-point types NaN propagates: if any value is NaN, Min returns NaN.
+func Min[T Number](values ...T) (T, error) {
+	if len(values) == 0 {
+		var zero T
+		return zero, ErrEmptyInput
+	}
+	result := values[0]
+	for _, v := range values {
+		if isNaN(v) {
+			return v, nil
+		}
+		if v < result {
+			result = v
+		}
+	}
+	return result, nil
+}
+
+// Max returns the largest of the given values.
+// It returns ErrEmptyInput if no values are given.
+// For floating-point types NaN propagates: if any value is NaN, Max returns NaN.
+func Max[T Number](values ...T) (T, error) {
+	if len(values) == 0 {
+		var zero T
+		return zero, ErrEmptyInput
+	}
+	result := values[0]
+	for _, v := range values {
+		if isNaN(v) {
+			return v, nil
+		}
+		if v > result {
+			result = v
+		}
+	}
+	return result, nil
+}
+
+// Clamp returns value bounded to the inclusive range [lo, hi].
+// A value exactly on a boundary is returned unchanged, and when lo == hi
+// the result is always lo. It returns ErrInvalidRange if lo > hi.
+func Clamp[T Number](value, lo, hi T) (T, error) {
+	if lo > hi {
+		var zero T
+		return zero, ErrInvalidRange
+	}
+	if value < lo {
+		return lo, nil
+	}
+	if value > hi {
+		return hi, nil
+	}
+	return value, nil
+}
+
+// ClampSlice returns a new slice with each element of values bounded to
+// [lo, hi] as by Clamp. The caller's slice is not modified, and an empty
+// input yields an empty, non-nil slice. It returns ErrInvalidRange if
+// lo > hi.
+func ClampSlice[T Number](values []T, lo, hi T) ([]T, error) {
+	if lo > hi {
+		return nil, ErrInvalidRange
+	}
+	result := make([]T, len(values))
+	for i, v := range values {
+		result[i], _ = Clamp(v, lo, hi)
+	}
+	return result, nil
+}
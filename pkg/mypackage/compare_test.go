@@ -0,0 +1,173 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMin(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		err      error
+	}{
+		{"single element", []int{4}, 4, nil},
+		{"multiple elements", []int{3, -1, 7, 0}, -1, nil},
+		{"minimum first", []int{-5, 2, 3}, -5, nil},
+		{"minimum last", []int{5, 2, 1}, 1, nil},
+		{"duplicates", []int{2, 2, 2}, 2, nil},
+		{"empty input", nil, 0, ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Min(tt.values...)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Min(%v) error = %v; want %v", tt.values, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Min(%v) = %d; want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		result, err := Min(2.5, -1.25, 3.0)
+		if err != nil || result != -1.25 {
+			t.Errorf("Min(2.5, -1.25, 3.0) = %f, %v; want -1.25, nil", result, err)
+		}
+	})
+
+	t.Run("NaN propagates", func(t *testing.T) {
+		result, err := Min(1.0, math.NaN(), -3.0)
+		if err != nil || !math.IsNaN(result) {
+			t.Errorf("Min(1.0, NaN, -3.0) = %f, %v; want NaN, nil", result, err)
+		}
+	})
+}
+
+func TestMax(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected int
+		err      error
+	}{
+		{"single element", []int{4}, 4, nil},
+		{"multiple elements", []int{3, -1, 7, 0}, 7, nil},
+		{"maximum first", []int{9, 2, 3}, 9, nil},
+		{"all negative", []int{-5, -2, -9}, -2, nil},
+		{"empty input", nil, 0, ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Max(tt.values...)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Max(%v) error = %v; want %v", tt.values, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Max(%v) = %d; want %d", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		result, err := Max(2.5, -1.25, 3.0)
+		if err != nil || result != 3.0 {
+			t.Errorf("Max(2.5, -1.25, 3.0) = %f, %v; want 3.0, nil", result, err)
+		}
+	})
+
+	t.Run("NaN propagates", func(t *testing.T) {
+		result, err := Max(1.0, 5.0, math.NaN())
+		if err != nil || !math.IsNaN(result) {
+			t.Errorf("Max(1.0, 5.0, NaN) = %f, %v; want NaN, nil", result, err)
+		}
+	})
+}
+
+func TestClamp(t *testing.T) {
+	tests := []struct {
+		name          string
+		value, lo, hi int
+		expected      int
+		err           error
+	}{
+		{"below range", -5, 0, 10, 0, nil},
+		{"above range", 15, 0, 10, 10, nil},
+		{"in range", 5, 0, 10, 5, nil},
+		{"on lower boundary", 0, 0, 10, 0, nil},
+		{"on upper boundary", 10, 0, 10, 10, nil},
+		{"degenerate range", 7, 3, 3, 3, nil},
+		{"invalid range", 5, 10, 0, 0, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Clamp(tt.value, tt.lo, tt.hi)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Clamp(%d, %d, %d) error = %v; want %v", tt.value, tt.lo, tt.hi, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Clamp(%d, %d, %d) = %d; want %d", tt.value, tt.lo, tt.hi, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		result, err := Clamp(1.5, 0.0, 1.0)
+		if err != nil || result != 1.0 {
+			t.Errorf("Clamp(1.5, 0.0, 1.0) = %f, %v; want 1.0, nil", result, err)
+		}
+	})
+}
+
+func TestClampSlice(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		lo, hi   int
+		expected []int
+		err      error
+	}{
+		{"below within and above", []int{-5, 0, 3, 10, 15}, 0, 10, []int{0, 0, 3, 10, 10}, nil},
+		{"all within", []int{1, 2, 3}, 0, 10, []int{1, 2, 3}, nil},
+		{"degenerate range", []int{-1, 5, 9}, 4, 4, []int{4, 4, 4}, nil},
+		{"empty slice", []int{}, 0, 1, []int{}, nil},
+		{"nil slice", nil, 0, 1, []int{}, nil},
+		{"invalid bounds", []int{1, 2}, 10, 0, nil, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ClampSlice(tt.values, tt.lo, tt.hi)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ClampSlice(%v, %d, %d) error = %v; want %v", tt.values, tt.lo, tt.hi, err, tt.err)
+			}
+			if tt.err == nil && (result == nil || !equalSlices(result, tt.expected)) {
+				t.Errorf("ClampSlice(%v, %d, %d) = %#v; want %v", tt.values, tt.lo, tt.hi, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{-0.5, 0.25, 1.5}
+		result, err := ClampSlice(values, 0, 1)
+		if expected := []float64{0, 0.25, 1}; err != nil || !equalSlices(result, expected) {
+			t.Errorf("ClampSlice(%v, 0, 1) = %v, %v; want %v, nil", values, result, err, expected)
+		}
+	})
+
+	t.Run("does not modify input", func(t *testing.T) {
+		values := []int{-5, 50}
+		if _, err := ClampSlice(values, 0, 10); err != nil {
+			t.Fatalf("ClampSlice(%v, 0, 10) returned error: %v", values, err)
+		}
+		if expected := []int{-5, 50}; !equalSlices(values, expected) {
+			t.Errorf("ClampSlice() mutated input: got %v; want %v", values, expected)
+		}
+	})
+}
@@ -0,0 +1,24 @@
+package mypackage
+
+import "math/cmplx"
+
+// AddComplex returns the sum of two complex128 numbers.
+func AddComplex(a, b complex128) complex128 {
+	return a + b
+}
+
+// MultiplyComplex returns the product of two complex128 numbers.
+func MultiplyComplex(a, b complex128) complex128 {
+	return a * b
+}
+
+// Magnitude returns the absolute value (modulus) of a complex number.
+func Magnitude(c complex128) float64 {
+	return cmplx.Abs(c)
+}
+
+// Phase returns the argument of a complex number in radians,
+// in the range [-Pi, Pi].
+func Phase(c complex128) float64 {
+	return cmplx.Phase(c)
+}
@@ -0,0 +1,85 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAddComplex(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     complex128
+		expected complex128
+	}{
+		{"general", 1 + 2i, 3 - 5i, 4 - 3i},
+		{"with zero", 1 + 1i, 0, 1 + 1i},
+		{"conjugates", 2 + 3i, 2 - 3i, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := AddComplex(tt.a, tt.b); result != tt.expected {
+				t.Errorf("AddComplex(%v, %v) = %v; want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMultiplyComplex(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     complex128
+		expected complex128
+	}{
+		{"conjugates", 1 + 1i, 1 - 1i, 2},
+		{"i squared", 1i, 1i, -1},
+		{"general", 1 + 2i, 3 + 4i, -5 + 10i},
+		{"by real", 2 - 3i, 2, 4 - 6i},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := MultiplyComplex(tt.a, tt.b); result != tt.expected {
+				t.Errorf("MultiplyComplex(%v, %v) = %v; want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMagnitude(t *testing.T) {
+	tests := []struct {
+		c        complex128
+		expected float64
+	}{
+		{3 + 4i, 5},
+		{-3 - 4i, 5},
+		{0, 0},
+		{-7, 7},
+		{2i, 2},
+	}
+
+	for _, tt := range tests {
+		if result := Magnitude(tt.c); result != tt.expected {
+			t.Errorf("Magnitude(%v) = %v; want %v", tt.c, result, tt.expected)
+		}
+	}
+}
+
+func TestPhase(t *testing.T) {
+	tests := []struct {
+		c        complex128
+		expected float64
+	}{
+		{1, 0},
+		{1i, math.Pi / 2},
+		{-1, math.Pi},
+		{-1i, -math.Pi / 2},
+		{1 + 1i, math.Pi / 4},
+	}
+
+	for _, tt := range tests {
+		if result := Phase(tt.c); math.Abs(result-tt.expected) > 1e-15 {
+			t.Errorf("Phase(%v) = %v; want %v", tt.c, result, tt.expected)
+		}
+	}
+}
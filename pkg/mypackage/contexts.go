@@ -0,0 +1,97 @@
+package mypackage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MergeContexts returns a context that is done as soon as any of ctxs is
+// done, with the Err of whichever finished first, or when the returned
+// cancel function is called. Its deadline is the earliest of their
+// deadlines, and Value looks the key up in each context in order.
+// Calling cancel releases the resources watching ctxs, so call it as soon
+// as the merged context is no longer needed, as with context.WithCancel.
+// With no arguments it behaves like context.WithCancel(context.Background()).
+func MergeContexts(ctxs ...context.Context) (context.Context, context.CancelFunc) {
+	if len(ctxs) == 0 {
+		return context.WithCancel(context.Background())
+	}
+
+	m := &mergedContext{ctxs: ctxs, done: make(chan struct{})}
+	for _, ctx := range ctxs {
+		if err := ctx.Err(); err != nil {
+			m.cancel(err)
+			return m, func() { m.cancel(context.Canceled) }
+		}
+	}
+
+	// Hold the lock while registering so a parent that finishes meanwhile
+	// waits in cancel until every stop function is recorded.
+	m.mu.Lock()
+	m.stops = make([]func() bool, 0, len(ctxs))
+	for _, ctx := range ctxs {
+		ctx := ctx
+		m.stops = append(m.stops, context.AfterFunc(ctx, func() { m.cancel(ctx.Err()) }))
+	}
+	m.mu.Unlock()
+	return m, func() { m.cancel(context.Canceled) }
+}
+
+// mergedContext is the context returned by MergeContexts.
+type mergedContext struct {
+	ctxs  []context.Context
+	done  chan struct{}
+	stops []func() bool
+
+	mu  sync.Mutex
+	err error
+}
+
+// cancel records err and closes done the first time it is called, and stops
+// watching the parent contexts.
+func (m *mergedContext) cancel(err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return
+	}
+	m.err = err
+	close(m.done)
+	for _, stop := range m.stops {
+		stop()
+	}
+}
+
+// Deadline returns the earliest deadline of the merged contexts.
+func (m *mergedContext) Deadline() (deadline time.Time, ok bool) {
+	for _, ctx := range m.ctxs {
+		if d, has := ctx.Deadline(); has && (!ok || d.Before(deadline)) {
+			deadline, ok = d, true
+		}
+	}
+	return deadline, ok
+}
+
+// Done returns a channel that is closed when the merged context is done.
+func (m *mergedContext) Done() <-chan struct{} {
+	return m.done
+}
+
+// Err returns nil until the merged context is done, then the error that
+// finished it.
+func (m *mergedContext) Err() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.err
+}
+
+// Value returns the first non-nil value for key among the merged contexts.
+func (m *mergedContext) Value(key any) any {
+	for _, ctx := range m.ctxs {
+		if v := ctx.Value(key); v != nil {
+			return v
+		}
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestMergeContexts(t *testing.T) {
+	waitDone := func(t *testing.T, ctx context.Context) {
+		t.Helper()
+		select {
+		case <-ctx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("merged context was not cancelled")
+		}
+	}
+
+	t.Run("cancelling any parent cancels the merged context", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			parents := make([]context.Context, 3)
+			cancels := make([]context.CancelFunc, 3)
+			for j := range parents {
+				parents[j], cancels[j] = context.WithCancel(context.Background())
+			}
+
+			merged, cancel := MergeContexts(parents...)
+			if err := merged.Err(); err != nil {
+				t.Fatalf("merged.Err() = %v before any cancellation; want nil", err)
+			}
+			cancels[i]()
+			waitDone(t, merged)
+			if err := merged.Err(); !errors.Is(err, context.Canceled) {
+				t.Errorf("merged.Err() after cancelling parent %d = %v; want %v", i, err, context.Canceled)
+			}
+
+			cancel()
+			for _, c := range cancels {
+				c()
+			}
+		}
+	})
+
+	t.Run("error of the first to finish", func(t *testing.T) {
+		shutdown, stop := context.WithCancel(context.Background())
+		defer stop()
+		request, cancelRequest := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancelRequest()
+
+		merged, cancel := MergeContexts(shutdown, request)
+		defer cancel()
+		waitDone(t, merged)
+		stop()
+
+		if err := merged.Err(); err != context.DeadlineExceeded {
+			t.Errorf("merged.Err() = %v; want %v", err, context.DeadlineExceeded)
+		}
+	})
+
+	t.Run("already cancelled parent", func(t *testing.T) {
+		ctx, cancelParent := context.WithCancel(context.Background())
+		cancelParent()
+
+		merged, cancel := MergeContexts(context.Background(), ctx)
+		defer cancel()
+		if err := merged.Err(); !errors.Is(err, context.Canceled) {
+			t.Errorf("merged.Err() = %v; want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("cancel func", func(t *testing.T) {
+		merged, cancel := MergeContexts(context.Background(), context.Background())
+		cancel()
+		waitDone(t, merged)
+		if err := merged.Err(); !errors.Is(err, context.Canceled) {
+			t.Errorf("merged.Err() = %v; want %v", err, context.Canceled)
+		}
+		cancel()
+	})
+
+	t.Run("deadline is the earliest", func(t *testing.T) {
+		soon := time.Now().Add(time.Minute)
+		later := soon.Add(time.Hour)
+		a, cancelA := context.WithDeadline(context.Background(), later)
+		defer cancelA()
+		b, cancelB := context.WithDeadline(context.Background(), soon)
+		defer cancelB()
+
+		merged, cancel := MergeContexts(a, context.Background(), b)
+		defer cancel()
+		if deadline, ok := merged.Deadline(); !ok || !deadline.Equal(soon) {
+			t.Errorf("merged.Deadline() = %v, %v; want %v, true", deadline, ok, soon)
+		}
+	})
+
+	t.Run("values from every parent", func(t *testing.T) {
+		type key string
+		a := context.WithValue(context.Background(), key("a"), 1)
+		b := context.WithValue(context.Background(), key("b"), 2)
+
+		merged, cancel := MergeContexts(a, b)
+		defer cancel()
+		if v := merged.Value(key("a")); v != 1 {
+			t.Errorf("merged.Value(a) = %v; want 1", v)
+		}
+		if v := merged.Value(key("b")); v != 2 {
+			t.Errorf("merged.Value(b) = %v; want 2", v)
+		}
+		if v := merged.Value(key("c")); v != nil {
+			t.Errorf("merged.Value(c) = %v; want nil", v)
+		}
+	})
+
+	t.Run("no contexts", func(t *testing.T) {
+		merged, cancel := MergeContexts()
+		if merged.Err() != nil {
+			t.Fatalf("merged.Err() = %v; want nil", merged.Err())
+		}
+		cancel()
+		waitDone(t, merged)
+	})
+
+	t.Run("cancel cleans up goroutines", func(t *testing.T) {
+		parent, cancelParent := context.WithCancel(context.Background())
+		defer cancelParent()
+
+		before := runtime.NumGoroutine()
+		for i := 0; i < 100; i++ {
+			merged, cancel := MergeContexts(parent, context.Background())
+			child, cancelChild := context.WithCancel(merged)
+			cancel()
+			<-child.Done()
+			cancelChild()
+		}
+
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(5 * time.Millisecond)
+		}
+		if after := runtime.NumGoroutine(); after > before {
+			t.Errorf("goroutines grew from %d to %d after cancelling merged contexts", before, after)
+		}
+	})
+}
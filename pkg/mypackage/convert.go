@@ -0,0 +1,32 @@
+package mypackage
+
+import "math"
+
+// ToInt32 converts n to an int32. Instead of truncating, it returns an
+// *ArithmeticError wrapping ErrOutOfRange when n is outside
+// [math.MinInt32, math.MaxInt32].
+func ToInt32(n int64) (int32, error) {
+	if n < math.MinInt32 || n > math.MaxInt32 {
+		return 0, &ArithmeticError{Op: "ToInt32", Err: ErrOutOfRange}
+	}
+	return int32(n), nil
+}
+
+// ToUint32 converts n to a uint32. It returns an *ArithmeticError wrapping
+// ErrOutOfRange when n is negative or greater than math.MaxUint32.
+func ToUint32(n int64) (uint32, error) {
+	if n < 0 || n > math.MaxUint32 {
+		return 0, &ArithmeticError{Op: "ToUint32", Err: ErrOutOfRange}
+	}
+	return uint32(n), nil
+}
+
+// ToInt converts n to an int. It always succeeds on 64-bit platforms; on
+// 32-bit platforms it returns an *ArithmeticError wrapping ErrOutOfRange
+// when n does not fit.
+func ToInt(n int64) (int, error) {
+	if n < math.MinInt || n > math.MaxInt {
+		return 0, &ArithmeticError{Op: "ToInt", Err: ErrOutOfRange}
+	}
+	return int(n), nil
+}
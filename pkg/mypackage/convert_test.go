@@ -0,0 +1,100 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestToInt32(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int64
+		expected int32
+		err      error
+	}{
+		{"zero", 0, 0, nil},
+		{"small negative", -42, -42, nil},
+		{"max", math.MaxInt32, math.MaxInt32, nil},
+		{"min", math.MinInt32, math.MinInt32, nil},
+		{"just above max", math.MaxInt32 + 1, 0, ErrOutOfRange},
+		{"just below min", math.MinInt32 - 1, 0, ErrOutOfRange},
+		{"far above", math.MaxInt64, 0, ErrOutOfRange},
+		{"far below", math.MinInt64, 0, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ToInt32(tt.n)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ToInt32(%d) error = %v; want %v", tt.n, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToInt32(%d) = %d; want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToUint32(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int64
+		expected uint32
+		err      error
+	}{
+		{"zero", 0, 0, nil},
+		{"max", math.MaxUint32, math.MaxUint32, nil},
+		{"just above max", math.MaxUint32 + 1, 0, ErrOutOfRange},
+		{"minus one", -1, 0, ErrOutOfRange},
+		{"far above", math.MaxInt64, 0, ErrOutOfRange},
+		{"far below", math.MinInt64, 0, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ToUint32(tt.n)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ToUint32(%d) error = %v; want %v", tt.n, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToUint32(%d) = %d; want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestToInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int64
+		expected int
+	}{
+		{"zero", 0, 0},
+		{"negative", -7, -7},
+		{"max int", math.MaxInt, math.MaxInt},
+		{"min int", math.MinInt, math.MinInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ToInt(tt.n)
+			if err != nil {
+				t.Fatalf("ToInt(%d) returned error: %v", tt.n, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ToInt(%d) = %d; want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+
+	if math.MaxInt < math.MaxInt64 {
+		t.Run("out of range on 32-bit", func(t *testing.T) {
+			for _, n := range []int64{math.MaxInt64, math.MinInt64} {
+				if _, err := ToInt(n); !errors.Is(err, ErrOutOfRange) {
+					t.Errorf("ToInt(%d) error = %v; want %v", n, err, ErrOutOfRange)
+				}
+			}
+		})
+	}
+}
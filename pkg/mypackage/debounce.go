@@ -0,0 +1,58 @@
+package mypackage
+
+import (
+	"sync"
+	"time"
+)
+
+// Debounce returns a debounced version of fn.
+// Each call to debounced restarts a timer, and fn runs only once d has
+// passed without another call. Calling cancel stops any pending
+// invocation; later calls to debounced start a new timer as usual.
+// Both returned functions are safe for concurrent use.
+func Debounce(d time.Duration, fn func()) (debounced func(), cancel func()) {
+	var mu sync.Mutex
+	var timer *time.Timer
+
+	debounced = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.AfterFunc(d, fn)
+	}
+
+	cancel = func() {
+		mu.Lock()
+		defer mu.Unlock()
+		if timer != nil {
+			timer.Stop()
+			timer = nil
+		}
+	}
+
+	return debounced, cancel
+}
+
+// Throttle returns a throttled version of fn that invokes fn at most once
+// per interval d. The first call runs fn immediately, in the calling
+// goroutine; calls made before d has passed since the last invocation are
+// ignored. The returned function is safe for concurrent use.
+func Throttle(d time.Duration, fn func()) (throttled func()) {
+	var mu sync.Mutex
+	var last time.Time
+
+	return func() {
+		mu.Lock()
+		now := time.Now()
+		if !last.IsZero() && now.Sub(last) < d {
+			mu.Unlock()
+			return
+		}
+		last = now
+		mu.Unlock()
+
+		fn()
+	}
+}
@@ -0,0 +1,123 @@
+package mypackage
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebounce(t *testing.T) {
+	t.Run("rapid calls collapse to one invocation", func(t *testing.T) {
+		var calls atomic.Int32
+		debounced, cancel := Debounce(30*time.Millisecond, func() { calls.Add(1) })
+		defer cancel()
+
+		for i := 0; i < 10; i++ {
+			debounced()
+			time.Sleep(2 * time.Millisecond)
+		}
+		time.Sleep(100 * time.Millisecond)
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn invoked %d times; want 1", n)
+		}
+	})
+
+	t.Run("concurrent calls collapse to one invocation", func(t *testing.T) {
+		var calls atomic.Int32
+		debounced, cancel := Debounce(30*time.Millisecond, func() { calls.Add(1) })
+		defer cancel()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				debounced()
+			}()
+		}
+		wg.Wait()
+		time.Sleep(100 * time.Millisecond)
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn invoked %d times; want 1", n)
+		}
+	})
+
+	t.Run("quiet periods fire separately", func(t *testing.T) {
+		var calls atomic.Int32
+		debounced, cancel := Debounce(10*time.Millisecond, func() { calls.Add(1) })
+		defer cancel()
+
+		debounced()
+		time.Sleep(50 * time.Millisecond)
+		debounced()
+		time.Sleep(50 * time.Millisecond)
+
+		if n := calls.Load(); n != 2 {
+			t.Errorf("fn invoked %d times; want 2", n)
+		}
+	})
+
+	t.Run("cancel prevents firing", func(t *testing.T) {
+		var calls atomic.Int32
+		debounced, cancel := Debounce(20*time.Millisecond, func() { calls.Add(1) })
+
+		debounced()
+		cancel()
+		time.Sleep(60 * time.Millisecond)
+
+		if n := calls.Load(); n != 0 {
+			t.Errorf("fn invoked %d times after cancel; want 0", n)
+		}
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("rapid calls within one interval invoke once", func(t *testing.T) {
+		var calls atomic.Int32
+		throttled := Throttle(time.Second, func() { calls.Add(1) })
+
+		for i := 0; i < 10; i++ {
+			throttled()
+		}
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn invoked %d times; want 1", n)
+		}
+	})
+
+	t.Run("concurrent calls within one interval invoke once", func(t *testing.T) {
+		var calls atomic.Int32
+		throttled := Throttle(time.Second, func() { calls.Add(1) })
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				throttled()
+			}()
+		}
+		wg.Wait()
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn invoked %d times; want 1", n)
+		}
+	})
+
+	t.Run("call after interval triggers again", func(t *testing.T) {
+		var calls atomic.Int32
+		throttled := Throttle(20*time.Millisecond, func() { calls.Add(1) })
+
+		throttled()
+		throttled()
+		time.Sleep(40 * time.Millisecond)
+		throttled()
+
+		if n := calls.Load(); n != 2 {
+			t.Errorf("fn invoked %d times; want 2", n)
+		}
+	})
+}
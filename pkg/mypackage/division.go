@@ -0,0 +1,113 @@
+package mypackage
+
+import "math"
+
+// Divide returns the quotient of two integers.
+// The result truncates toward zero, like Go's / operator.
+// It returns ErrDivideByZero if b is zero and ErrOverflow for
+// Divide(math.MinInt, -1), whose quotient does not fit in an int.
+func Divide(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "Divide", Err: ErrDivideByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, &ArithmeticError{Op: "Divide", Err: ErrOverflow}
+	}
+	return a / b, nil
+}
+
+// DivideFloat returns the quotient of two float64 numbers.
+// It returns ErrDivideByZero if b is zero rather than producing +Inf, -Inf, or NaN.
+func DivideFloat(a, b float64) (float64, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "DivideFloat", Err: ErrDivideByZero}
+	}
+	return a / b, nil
+}
+
+// Mod returns the remainder of a divided by b, matching Go's % operator.
+// The result has the same sign as a, so Mod(-1, 3) is -1.
+// It returns ErrDivideByZero if b is zero.
+func Mod(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "Mod", Err: ErrDivideByZero}
+	}
+	return a % b, nil
+}
+
+// EuclideanMod returns the Euclidean remainder of a divided by b.
+// Unlike Mod, the result is always in the range [0, |b|), which makes it
+// suitable for clock arithmetic: EuclideanMod(-1, 3) is 2.
+// It returns ErrDivideByZero if b is zero.
+func EuclideanMod(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "EuclideanMod", Err: ErrDivideByZero}
+	}
+	r := a % b
+	if r < 0 {
+		if b < 0 {
+			r -= b
+		} else {
+			r += b
+		}
+	}
+	return r, nil
+}
+
+// FloorDiv returns the quotient of a and b rounded down toward negative
+// infinity, so FloorDiv(-7, 2) is -4. It returns ErrDivideByZero if b is zero.
+// Like Divide, it returns ErrOverflow for math.MinInt and -1.
+func FloorDiv(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "FloorDiv", Err: ErrDivideByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, &ArithmeticError{Op: "FloorDiv", Err: ErrOverflow}
+	}
+	q := a / b
+	if a%b != 0 && (a < 0) != (b < 0) {
+		q--
+	}
+	return q, nil
+}
+
+// CeilDiv returns the quotient of a and b rounded up toward positive
+// infinity, so CeilDiv(7, 2) is 4. This is handy for counting pages:
+// CeilDiv(items, pageSize). It returns ErrDivideByZero if b is zero.
+// Like Divide, it returns ErrOverflow for math.MinInt and -1.
+func CeilDiv(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "CeilDiv", Err: ErrDivideByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, &ArithmeticError{Op: "CeilDiv", Err: ErrOverflow}
+	}
+	q := a / b
+	if a%b != 0 && (a < 0) == (b < 0) {
+		q++
+	}
+	return q, nil
+}
+
+// RoundDiv returns the quotient of a and b rounded to the nearest integer,
+// with halves rounded away from zero, so RoundDiv(5, 2) is 3 and
+// RoundDiv(-5, 2) is -3. It returns ErrDivideByZero if b is zero.
+// Like Divide, it returns ErrOverflow for math.MinInt and -1.
+func RoundDiv(a, b int) (int, error) {
+	if b == 0 {
+		return 0, &ArithmeticError{Op: "RoundDiv", Err: ErrDivideByZero}
+	}
+	if a == math.MinInt && b == -1 {
+		return 0, &ArithmeticError{Op: "RoundDiv", Err: ErrOverflow}
+	}
+	q := a / b
+	r, d := absUint(a%b), absUint(b)
+	if r != 0 && r >= d-r {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q, nil
+}
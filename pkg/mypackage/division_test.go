@@ -0,0 +1,183 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestDivide(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		err      error
+	}{
+		{"exact division", 6, 3, 2, nil},
+		{"truncates positive", 7, 2, 3, nil},
+		{"truncates negative dividend", -7, 2, -3, nil},
+		{"truncates negative divisor", 7, -2, -3, nil},
+		{"both negative", -7, -2, 3, nil},
+		{"zero dividend", 0, 5, 0, nil},
+		{"division by zero", 5, 0, 0, ErrDivideByZero},
+		{"min int by minus one", math.MinInt, -1, 0, ErrOverflow},
+		{"min int by one", math.MinInt, 1, math.MinInt, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Divide(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Divide(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Divide(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestDivideFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+		err      error
+	}{
+		{"positive floats", 7.5, 2.5, 3.0, nil},
+		{"fractional result", 1.0, 4.0, 0.25, nil},
+		{"negative divisor", 3.0, -2.0, -1.5, nil},
+		{"division by zero", 1.0, 0.0, 0, ErrDivideByZero},
+		{"division by negative zero", 1.0, math.Copysign(0, -1), 0, ErrDivideByZero},
+		{"zero by zero", 0.0, 0.0, 0, ErrDivideByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DivideFloat(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("DivideFloat(%f, %f) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("DivideFloat(%f, %f) = %f; want %f", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		err      error
+	}{
+		{"positive operands", 7, 3, 1, nil},
+		{"exact multiple", 9, 3, 0, nil},
+		{"negative dividend", -1, 3, -1, nil},
+		{"negative divisor", 7, -3, 1, nil},
+		{"both negative", -7, -3, -1, nil},
+		{"division by zero", 7, 0, 0, ErrDivideByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Mod(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Mod(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Mod(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestEuclideanMod(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		err      error
+	}{
+		{"positive operands", 7, 3, 1, nil},
+		{"exact multiple", -9, 3, 0, nil},
+		{"negative dividend", -1, 3, 2, nil},
+		{"clock arithmetic", -13, 12, 11, nil},
+		{"negative divisor", 7, -3, 1, nil},
+		{"both negative", -7, -3, 2, nil},
+		{"min int divisor", -1, math.MinInt, math.MaxInt, nil},
+		{"division by zero", 7, 0, 0, ErrDivideByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := EuclideanMod(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("EuclideanMod(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("EuclideanMod(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRoundingDivision(t *testing.T) {
+	tests := []struct {
+		name                 string
+		a, b                 int
+		floor, ceil, rounded int
+	}{
+		{"exact division", 6, 3, 2, 2, 2},
+		{"exact negative division", -6, 3, -2, -2, -2},
+		{"small remainder", 7, 3, 2, 3, 2},
+		{"large remainder", 8, 3, 2, 3, 3},
+		{"half", 5, 2, 2, 3, 3},
+		{"negative dividend small remainder", -7, 3, -3, -2, -2},
+		{"negative dividend large remainder", -8, 3, -3, -2, -3},
+		{"negative half", -5, 2, -3, -2, -3},
+		{"negative divisor", 7, -2, -4, -3, -4},
+		{"both negative", -7, -2, 3, 4, 4},
+		{"zero dividend", 0, 5, 0, 0, 0},
+		{"min int by max int", math.MinInt, math.MaxInt, -2, -1, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result, err := FloorDiv(tt.a, tt.b); err != nil || result != tt.floor {
+				t.Errorf("FloorDiv(%d, %d) = %d, %v; want %d, nil", tt.a, tt.b, result, err, tt.floor)
+			}
+			if result, err := CeilDiv(tt.a, tt.b); err != nil || result != tt.ceil {
+				t.Errorf("CeilDiv(%d, %d) = %d, %v; want %d, nil", tt.a, tt.b, result, err, tt.ceil)
+			}
+			if result, err := RoundDiv(tt.a, tt.b); err != nil || result != tt.rounded {
+				t.Errorf("RoundDiv(%d, %d) = %d, %v; want %d, nil", tt.a, tt.b, result, err, tt.rounded)
+			}
+		})
+	}
+
+	t.Run("division by zero", func(t *testing.T) {
+		if _, err := FloorDiv(1, 0); !errors.Is(err, ErrDivideByZero) {
+			t.Errorf("FloorDiv(1, 0) error = %v; want %v", err, ErrDivideByZero)
+		}
+		if _, err := CeilDiv(1, 0); !errors.Is(err, ErrDivideByZero) {
+			t.Errorf("CeilDiv(1, 0) error = %v; want %v", err, ErrDivideByZero)
+		}
+		if _, err := RoundDiv(1, 0); !errors.Is(err, ErrDivideByZero) {
+			t.Errorf("RoundDiv(1, 0) error = %v; want %v", err, ErrDivideByZero)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		if _, err := FloorDiv(math.MinInt, -1); !errors.Is(err, ErrOverflow) {
+			t.Errorf("FloorDiv(math.MinInt, -1) error = %v; want %v", err, ErrOverflow)
+		}
+		if _, err := CeilDiv(math.MinInt, -1); !errors.Is(err, ErrOverflow) {
+			t.Errorf("CeilDiv(math.MinInt, -1) error = %v; want %v", err, ErrOverflow)
+		}
+		if _, err := RoundDiv(math.MinInt, -1); !errors.Is(err, ErrOverflow) {
+			t.Errorf("RoundDiv(math.MinInt, -1) error = %v; want %v", err, ErrOverflow)
+		}
+	})
+}
@@ -0,0 +1,78 @@
+package mypackage
+
+import "errors"
+
+// ErrOverflow is returned when the result of an integer operation
+// cannot be represented without wrapping around.
+var ErrOverflow = errors.New("integer overflow")
+
+// ErrDivideByZero is returned when a division or modulo operation
+// is given a zero divisor.
+var ErrDivideByZero = errors.New("division by zero")
+
+// ErrNegative is returned when a function is given a negative argument
+// it cannot accept, such as a negative exponent.
+var ErrNegative = errors.New("negative argument")
+
+// ErrEmptyInput is returned when a function that needs at least one value
+// is given none.
+var ErrEmptyInput = errors.New("empty input")
+
+// ErrInvalidRange is returned when a range is malformed, such as when its
+// lower bound is greater than its upper bound.
+var ErrInvalidRange = errors.New("invalid range")
+
+// ErrInsufficientData is returned when a statistic needs more values than
+// it was given, such as a sample variance of a single value.
+var ErrInsufficientData = errors.New("insufficient data")
+
+// ErrInvalidVersion is returned when a string is not a valid semantic version.
+var ErrInvalidVersion = errors.New("invalid semantic version")
+
+// ErrInvalidNumber is returned when a string cannot be parsed as a number.
+var ErrInvalidNumber = errors.New("invalid number")
+
+// ErrLengthMismatch is returned when two slices that must have the same
+// length do not.
+var ErrLengthMismatch = errors.New("length mismatch")
+
+// ErrDimensionMismatch is returned when matrices have incompatible or
+// inconsistent dimensions.
+var ErrDimensionMismatch = errors.New("dimension mismatch")
+
+// ErrInvalidDuration is returned when a function that needs a positive
+// duration is given zero or a negative one.
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// ErrOutOfRange is returned when a value cannot be represented in the
+// target type of a conversion.
+var ErrOutOfRange = errors.New("value out of range")
+
+// ErrNoInverse is returned when a value has no modular inverse because it
+// is not coprime to the modulus.
+var ErrNoInverse = errors.New("no modular inverse")
+
+// ArithmeticError records which operation failed and why. Every checked
+// arithmetic function reports its failures as an *ArithmeticError whose Op
+// is the function's name, so where a doc comment says a function returns a
+// sentinel such as ErrOverflow, the error wraps it. Callers can test for the
+// sentinel with errors.Is and recover the operation with errors.As.
+type ArithmeticError struct {
+	Op  string
+	Err error
+}
+
+// Error returns the operation followed by the underlying error, such as
+// "AddChecked: integer overflow".
+func (e *ArithmeticError) Error() string {
+	return e.Op + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *ArithmeticError) Unwrap() error {
+	return e.Err
+}
+
+// ErrCircuitOpen is returned by CircuitBreaker.Execute when the breaker is
+// open and the call was rejected without running.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
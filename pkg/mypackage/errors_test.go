@@ -0,0 +1,102 @@
+package mypackage
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+)
+
+func TestArithmeticError(t *testing.T) {
+	sentinels := []error{ErrOverflow, ErrDivideByZero, ErrNegative, ErrEmptyInput, ErrOutOfRange}
+
+	t.Run("errors.Is matches only the wrapped sentinel", func(t *testing.T) {
+		for _, sentinel := range sentinels {
+			err := error(&ArithmeticError{Op: "op", Err: sentinel})
+			for _, other := range sentinels {
+				if got, want := errors.Is(err, other), other == sentinel; got != want {
+					t.Errorf("errors.Is(%v, %v) = %v; want %v", err, other, got, want)
+				}
+			}
+		}
+	})
+
+	t.Run("message", func(t *testing.T) {
+		err := &ArithmeticError{Op: "AddChecked", Err: ErrOverflow}
+		if got, want := err.Error(), "AddChecked: integer overflow"; got != want {
+			t.Errorf("Error() = %q; want %q", got, want)
+		}
+	})
+
+	t.Run("survives further wrapping", func(t *testing.T) {
+		err := fmt.Errorf("computing total: %w", &ArithmeticError{Op: "ToInt32", Err: ErrOutOfRange})
+		var arithErr *ArithmeticError
+		if !errors.As(err, &arithErr) || arithErr.Op != "ToInt32" {
+			t.Fatalf("errors.As(%v) = %v; want *ArithmeticError with Op ToInt32", err, arithErr)
+		}
+		if !errors.Is(err, ErrOutOfRange) {
+			t.Errorf("errors.Is(%v, ErrOutOfRange) = false; want true", err)
+		}
+	})
+}
+
+func TestCheckedFunctionErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func() error
+		sentinel error
+		op       string
+	}{
+		{"AddChecked overflow", func() error { _, err := AddChecked(math.MaxInt, 1); return err }, ErrOverflow, "AddChecked"},
+		{"AbsIntChecked overflow", func() error { _, err := AbsIntChecked(math.MinInt); return err }, ErrOverflow, "AbsIntChecked"},
+		{"ToInt32 out of range", func() error { _, err := ToInt32(math.MaxInt64); return err }, ErrOutOfRange, "ToInt32"},
+		{"ToUint32 out of range", func() error { _, err := ToUint32(-1); return err }, ErrOutOfRange, "ToUint32"},
+		{"Divide by zero", func() error { _, err := Divide(1, 0); return err }, ErrDivideByZero, "Divide"},
+		{"Divide overflow", func() error { _, err := Divide(math.MinInt, -1); return err }, ErrOverflow, "Divide"},
+		{"DivideFloat by zero", func() error { _, err := DivideFloat(1, 0); return err }, ErrDivideByZero, "DivideFloat"},
+		{"Mod by zero", func() error { _, err := Mod(1, 0); return err }, ErrDivideByZero, "Mod"},
+		{"EuclideanMod by zero", func() error { _, err := EuclideanMod(1, 0); return err }, ErrDivideByZero, "EuclideanMod"},
+		{"FloorDiv by zero", func() error { _, err := FloorDiv(1, 0); return err }, ErrDivideByZero, "FloorDiv"},
+		{"CeilDiv by zero", func() error { _, err := CeilDiv(1, 0); return err }, ErrDivideByZero, "CeilDiv"},
+		{"RoundDiv by zero", func() error { _, err := RoundDiv(1, 0); return err }, ErrDivideByZero, "RoundDiv"},
+		{"FloorDiv overflow", func() error { _, err := FloorDiv(math.MinInt, -1); return err }, ErrOverflow, "FloorDiv"},
+		{"PowInt negative", func() error { _, err := PowInt(2, -1); return err }, ErrNegative, "PowInt"},
+		{"PowInt overflow", func() error { _, err := PowInt(2, 64); return err }, ErrOverflow, "PowInt"},
+		{"Factorial negative", func() error { _, err := Factorial(-1); return err }, ErrNegative, "Factorial"},
+		{"Factorial overflow", func() error { _, err := Factorial(100); return err }, ErrOverflow, "Factorial"},
+		{"FactorialBig negative", func() error { _, err := FactorialBig(-1); return err }, ErrNegative, "FactorialBig"},
+		{"Fibonacci negative", func() error { _, err := Fibonacci(-1); return err }, ErrNegative, "Fibonacci"},
+		{"Fibonacci overflow", func() error { _, err := Fibonacci(200); return err }, ErrOverflow, "Fibonacci"},
+		{"FibonacciSeq overflow", func() error { _, err := FibonacciSeq(200); return err }, ErrOverflow, "FibonacciSeq"},
+		{"FibonacciBig negative", func() error { _, err := FibonacciBig(-1); return err }, ErrNegative, "FibonacciBig"},
+		{"LCM overflow", func() error { _, err := LCM(math.MaxInt, 2); return err }, ErrOverflow, "LCM"},
+		{"ShiftLeft overflow", func() error { _, err := ShiftLeft(1, 200); return err }, ErrOverflow, "ShiftLeft"},
+		{"ModPow zero modulus", func() error { _, err := ModPow(2, 3, 0); return err }, ErrDivideByZero, "ModPow"},
+		{"ModPow negative exponent", func() error { _, err := ModPow(2, -3, 5); return err }, ErrNegative, "ModPow"},
+		{"ModInverse zero modulus", func() error { _, err := ModInverse(3, 0); return err }, ErrDivideByZero, "ModInverse"},
+		{"ModInverse not coprime", func() error { _, err := ModInverse(6, 9); return err }, ErrNoInverse, "ModInverse"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("error = %v; want errors.Is(err, %v)", err, tt.sentinel)
+			}
+			var arithErr *ArithmeticError
+			if !errors.As(err, &arithErr) {
+				t.Fatalf("error = %#v; want *ArithmeticError", err)
+			}
+			if arithErr.Op != tt.op {
+				t.Errorf("ArithmeticError.Op = %q; want %q", arithErr.Op, tt.op)
+			}
+		})
+	}
+
+	t.Run("nested operations keep the chain", func(t *testing.T) {
+		_, err := FibonacciSeq(200)
+		if got, want := err.Error(), "FibonacciSeq: Fibonacci: AddChecked: integer overflow"; got != want {
+			t.Errorf("FibonacciSeq(200) error = %q; want %q", got, want)
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package mypackage
+
+import "math"
+
+// FloatEqual reports whether a and b differ by at most epsilon.
+// NaN is never equal to anything, including itself. Infinities are equal
+// only to an infinity of the same sign, whatever epsilon is.
+func FloatEqual(a, b, epsilon float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	return math.Abs(a-b) <= epsilon
+}
+
+// FloatEqualRel reports whether a and b differ by at most relTol times the
+// larger of their magnitudes, so FloatEqualRel(1000, 1001, 1e-3) is true.
+// NaN and infinities are handled as in FloatEqual. Because the tolerance
+// scales with the inputs, a non-zero value is never relatively equal to
+// zero; use FloatEqual for comparisons near zero.
+func FloatEqualRel(a, b, relTol float64) bool {
+	if a == b {
+		return true
+	}
+	if math.IsNaN(a) || math.IsNaN(b) || math.IsInf(a, 0) || math.IsInf(b, 0) {
+		return false
+	}
+	return math.Abs(a-b) <= relTol*math.Max(math.Abs(a), math.Abs(b))
+}
+
+// FloatEqualULP reports whether a and b are at most maxULPs representable
+// float64 values apart, counting units in the last place. Adjacent floats
+// are 1 ULP apart and +0 equals -0. Values of opposite sign are compared
+// across zero, so they are only equal when both are within maxULPs of it.
+// NaN is never equal to anything, including itself.
+func FloatEqualULP(a, b float64, maxULPs uint) bool {
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return false
+	}
+	ia, ib := orderedBits(a), orderedBits(b)
+	var diff uint64
+	if ia > ib {
+		diff = uint64(ia) - uint64(ib)
+	} else {
+		diff = uint64(ib) - uint64(ia)
+	}
+	return diff <= uint64(maxULPs)
+}
+
+// orderedBits maps f to an integer that increases by one from each float64
+// to the next larger one, with both zeros mapped to 0.
+func orderedBits(f float64) int64 {
+	b := int64(math.Float64bits(f))
+	if b < 0 {
+		return math.MinInt64 - b
+	}
+	return b
+}
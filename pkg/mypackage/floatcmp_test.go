@@ -0,0 +1,110 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFloatEqual(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		name     string
+		a, b     float64
+		epsilon  float64
+		expected bool
+	}{
+		{"identical", 1.5, 1.5, 0, true},
+		{"classic sum", 0.1 + 0.2, 0.3, 1e-12, true},
+		{"within epsilon", 1.0, 1.0005, 1e-3, true},
+		{"outside epsilon", 1.0, 1.01, 1e-3, false},
+		{"zero vs tiny", 0, 1e-300, 1e-12, true},
+		{"zero vs negative zero", 0, math.Copysign(0, -1), 0, true},
+		{"NaN vs NaN", nan, nan, 1, false},
+		{"NaN vs number", nan, 1, math.MaxFloat64, false},
+		{"positive infinities", inf, inf, 0, true},
+		{"opposite infinities", inf, -inf, math.MaxFloat64, false},
+		{"infinity vs large", inf, math.MaxFloat64, inf, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FloatEqual(tt.a, tt.b, tt.epsilon); result != tt.expected {
+				t.Errorf("FloatEqual(%v, %v, %v) = %v; want %v", tt.a, tt.b, tt.epsilon, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFloatEqualRel(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+
+	tests := []struct {
+		name     string
+		a, b     float64
+		relTol   float64
+		expected bool
+	}{
+		{"identical", 1.5, 1.5, 0, true},
+		{"classic sum", 0.1 + 0.2, 0.3, 1e-9, true},
+		{"large values within tolerance", 1e20, 1.0000001e20, 1e-6, true},
+		{"large values outside tolerance", 1e20, 1.1e20, 1e-6, false},
+		{"scaled within tolerance", 1000, 1001, 1e-3, true},
+		{"small values within tolerance", 1e-20, 1.0000001e-20, 1e-6, true},
+		{"zero vs tiny", 0, 1e-300, 1e-9, false},
+		{"zero vs zero", 0, 0, 0, true},
+		{"NaN vs NaN", nan, nan, 1, false},
+		{"positive infinities", inf, inf, 0, true},
+		{"negative infinities", -inf, -inf, 0, true},
+		{"opposite infinities", inf, -inf, 1, false},
+		{"infinity vs finite", inf, 1, 1, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FloatEqualRel(tt.a, tt.b, tt.relTol); result != tt.expected {
+				t.Errorf("FloatEqualRel(%v, %v, %v) = %v; want %v", tt.a, tt.b, tt.relTol, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFloatEqualULP(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	next := math.Nextafter(1, 2)
+	twoAfter := math.Nextafter(next, 2)
+	tiny := math.SmallestNonzeroFloat64
+
+	tests := []struct {
+		name     string
+		a, b     float64
+		maxULPs  uint
+		expected bool
+	}{
+		{"identical", 1.5, 1.5, 0, true},
+		{"adjacent with zero ULPs", 1, next, 0, false},
+		{"adjacent with one ULP", 1, next, 1, true},
+		{"adjacent reversed", next, 1, 1, true},
+		{"two apart with one ULP", 1, twoAfter, 1, false},
+		{"two apart with two ULPs", 1, twoAfter, 2, true},
+		{"below one", 1, math.Nextafter(1, 0), 1, true},
+		{"sum rounding", 0.1 + 0.2, 0.3, 1, true},
+		{"negative adjacent", -1, -next, 1, true},
+		{"signed zeros", 0, math.Copysign(0, -1), 0, true},
+		{"smallest subnormals across zero", tiny, -tiny, 1, false},
+		{"smallest subnormals across zero within two", tiny, -tiny, 2, true},
+		{"opposite signs", 1, -1, 1000, false},
+		{"max and infinity", math.MaxFloat64, inf, 1, true},
+		{"infinities", inf, -inf, math.MaxUint32, false},
+		{"NaN with itself", nan, nan, math.MaxUint32, false},
+		{"NaN with number", nan, 1, math.MaxUint32, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := FloatEqualULP(tt.a, tt.b, tt.maxULPs); result != tt.expected {
+				t.Errorf("FloatEqualULP(%v, %v, %d) = %v; want %v", tt.a, tt.b, tt.maxULPs, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package mypackage
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// FormatBytes formats a byte count using binary (1024-based) units,
+// such as "512 B", "1.5 KiB", or "2.0 MiB". Negative counts keep their
+// sign, and counts below 1024 are printed exactly.
+func FormatBytes(n int64) string {
+	return formatBytes(n, 1024, "KMGTPE", "i")
+}
+
+// FormatBytesSI formats a byte count using SI decimal (1000-based) units,
+// such as "512 B", "1.5 kB", or "2.0 MB". Negative counts keep their sign,
+// and counts below 1000 are printed exactly.
+func FormatBytesSI(n int64) string {
+	return formatBytes(n, 1000, "kMGTPE", "")
+}
+
+// formatBytes formats n in multiples of unit, using one decimal place and
+// the given prefixes, with infix inserted between prefix and "B".
+func formatBytes(n int64, unit uint64, prefixes, infix string) string {
+	sign := ""
+	u := uint64(n)
+	if n < 0 {
+		sign = "-"
+		u = uint64(-n)
+	}
+	if u < unit {
+		return fmt.Sprintf("%s%d B", sign, u)
+	}
+
+	value := float64(u) / float64(unit)
+	exp := 0
+	// Move to the next prefix when the value would otherwise print as
+	// 1024.0 KiB, for example.
+	for math.Round(value*10)/10 >= float64(unit) && exp < len(prefixes)-1 {
+		value /= float64(unit)
+		exp++
+	}
+	return fmt.Sprintf("%s%.1f %c%sB", sign, value, prefixes[exp], infix)
+}
+
+// durationUnits lists the components used by HumanizeDuration, largest first.
+var durationUnits = []struct {
+	size   time.Duration
+	suffix string
+}{
+	{24 * time.Hour, "d"},
+	{time.Hour, "h"},
+	{time.Minute, "m"},
+	{time.Second, "s"},
+	{time.Millisecond, "ms"},
+	{time.Microsecond, "µs"},
+	{time.Nanosecond, "ns"},
+}
+
+// HumanizeDuration formats d as space-separated components from days down
+// to nanoseconds, such as "2h 3m 4s" or "1s 500ms", omitting components
+// that are zero. A day is always 24 hours. Negative durations are prefixed
+// with "-", and a zero duration is formatted as "0s".
+func HumanizeDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	sign := ""
+	u := uint64(d)
+	if d < 0 {
+		sign = "-"
+		u = uint64(-d)
+	}
+
+	parts := make([]string, 0, len(durationUnits))
+	for _, unit := range durationUnits {
+		size := uint64(unit.size)
+		if n := u / size; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d%s", n, unit.suffix))
+			u %= size
+		}
+	}
+	return sign + strings.Join(parts, " ")
+}
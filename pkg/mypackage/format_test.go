@@ -0,0 +1,91 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatBytes(t *testing.T) {
+	tests := []struct {
+		n        int64
+		expected string
+	}{
+		{0, "0 B"},
+		{1, "1 B"},
+		{1023, "1023 B"},
+		{1024, "1.0 KiB"},
+		{1536, "1.5 KiB"},
+		{1048575, "1.0 MiB"},
+		{1048576, "1.0 MiB"},
+		{2 * 1048576, "2.0 MiB"},
+		{1 << 30, "1.0 GiB"},
+		{1 << 40, "1.0 TiB"},
+		{1 << 50, "1.0 PiB"},
+		{1 << 60, "1.0 EiB"},
+		{math.MaxInt64, "8.0 EiB"},
+		{-1023, "-1023 B"},
+		{-1536, "-1.5 KiB"},
+		{math.MinInt64, "-8.0 EiB"},
+	}
+
+	for _, tt := range tests {
+		if result := FormatBytes(tt.n); result != tt.expected {
+			t.Errorf("FormatBytes(%d) = %q; want %q", tt.n, result, tt.expected)
+		}
+	}
+}
+
+func TestFormatBytesSI(t *testing.T) {
+	tests := []struct {
+		n        int64
+		expected string
+	}{
+		{0, "0 B"},
+		{999, "999 B"},
+		{1000, "1.0 kB"},
+		{1023, "1.0 kB"},
+		{1024, "1.0 kB"},
+		{1500, "1.5 kB"},
+		{999999, "1.0 MB"},
+		{1048576, "1.0 MB"},
+		{2000000, "2.0 MB"},
+		{1e9, "1.0 GB"},
+		{1e18, "1.0 EB"},
+		{math.MaxInt64, "9.2 EB"},
+		{-1500, "-1.5 kB"},
+	}
+
+	for _, tt := range tests {
+		if result := FormatBytesSI(tt.n); result != tt.expected {
+			t.Errorf("FormatBytesSI(%d) = %q; want %q", tt.n, result, tt.expected)
+		}
+	}
+}
+
+func TestHumanizeDuration(t *testing.T) {
+	tests := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "0s"},
+		{2*time.Hour + 3*time.Minute + 4*time.Second, "2h 3m 4s"},
+		{26 * time.Hour, "1d 2h"},
+		{3*24*time.Hour + 5*time.Second, "3d 5s"},
+		{90 * time.Minute, "1h 30m"},
+		{time.Minute, "1m"},
+		{1500 * time.Millisecond, "1s 500ms"},
+		{250 * time.Millisecond, "250ms"},
+		{1500 * time.Microsecond, "1ms 500µs"},
+		{42 * time.Nanosecond, "42ns"},
+		{-90 * time.Second, "-1m 30s"},
+		{-5 * time.Millisecond, "-5ms"},
+		{time.Duration(math.MinInt64), "-106751d 23h 47m 16s 854ms 775µs 808ns"},
+	}
+
+	for _, tt := range tests {
+		if result := HumanizeDuration(tt.d); result != tt.expected {
+			t.Errorf("HumanizeDuration(%v) = %q; want %q", tt.d, result, tt.expected)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package mypackage
+
+// Lerp returns the linear interpolation between a and b at t, computed as
+// a*(1-t) + b*t so that Lerp(a, b, 0) is exactly a and Lerp(a, b, 1) is
+// exactly b.
+// Values of t outside [0, 1] extrapolate beyond a and b along the same
+// line; use LerpClamped to stay between them.
+func Lerp(a, b, t float64) float64 {
+	return a*(1-t) + b*t
+}
+
+// LerpClamped is like Lerp, but clamps t to [0, 1] first, so the result
+// always lies between a and b.
+func LerpClamped(a, b, t float64) float64 {
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+	return Lerp(a, b, t)
+}
+
+// Remap linearly maps value from the range [inMin, inMax] to the range
+// [outMin, outMax], so inMin maps to outMin and inMax maps to outMax.
+// The output range may be inverted (outMin > outMax), and values outside
+// the input range are extrapolated rather than clamped.
+// It returns ErrInvalidRange if inMin == inMax.
+func Remap(value, inMin, inMax, outMin, outMax float64) (float64, error) {
+	if inMin == inMax {
+		return 0, ErrInvalidRange
+	}
+	t := (value - inMin) / (inMax - inMin)
+	return Lerp(outMin, outMax, t), nil
+}
@@ -0,0 +1,89 @@
+package mypackage
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestLerp(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b, t  float64
+		expected float64
+	}{
+		{"start", 10, 20, 0, 10},
+		{"midpoint", 10, 20, 0.5, 15},
+		{"end", 10, 20, 1, 20},
+		{"decreasing range", 20, 10, 0.25, 17.5},
+		{"extrapolate below", 10, 20, -0.5, 5},
+		{"extrapolate above", 10, 20, 1.5, 25},
+		{"exact end", -1, 1e-17, 1, 1e-17},
+		{"exact start", 1e-17, -1, 0, 1e-17},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Lerp(tt.a, tt.b, tt.t)
+			if result != tt.expected {
+				t.Errorf("Lerp(%v, %v, %v) = %v; want %v", tt.a, tt.b, tt.t, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLerpClamped(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b, t  float64
+		expected float64
+	}{
+		{"start", 10, 20, 0, 10},
+		{"midpoint", 10, 20, 0.5, 15},
+		{"end", 10, 20, 1, 20},
+		{"clamped below", 10, 20, -0.5, 10},
+		{"clamped above", 10, 20, 1.5, 20},
+		{"decreasing range clamped", 20, 10, 2, 10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := LerpClamped(tt.a, tt.b, tt.t)
+			if result != tt.expected {
+				t.Errorf("LerpClamped(%v, %v, %v) = %v; want %v", tt.a, tt.b, tt.t, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRemap(t *testing.T) {
+	tests := []struct {
+		name                                string
+		value, inMin, inMax, outMin, outMax float64
+		expected                            float64
+		err                                 error
+	}{
+		{"midpoint", 5, 0, 10, 0, 100, 50, nil},
+		{"input minimum", 0, 0, 10, 20, 40, 20, nil},
+		{"input maximum", 10, 0, 10, 20, 40, 40, nil},
+		{"sensor scaling", 512, 0, 1024, -1, 1, 0, nil},
+		{"inverted output", 2.5, 0, 10, 100, 0, 75, nil},
+		{"inverted input", 2.5, 10, 0, 0, 100, 75, nil},
+		{"below input range", -5, 0, 10, 0, 100, -50, nil},
+		{"above input range", 15, 0, 10, 0, 100, 150, nil},
+		{"degenerate range", 5, 3, 3, 0, 100, 0, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Remap(tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Remap(%v, %v, %v, %v, %v) error = %v; want %v",
+					tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("Remap(%v, %v, %v, %v, %v) = %v; want %v",
+					tt.value, tt.inMin, tt.inMax, tt.outMin, tt.outMax, result, tt.expected)
+			}
+		})
+	}
+}
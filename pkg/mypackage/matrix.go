@@ -0,0 +1,70 @@
+package mypackage
+
+import "fmt"
+
+// Matrix is a dense matrix of float64 values stored as a slice of rows.
+type Matrix [][]float64
+
+// MatrixMultiply returns the matrix product a × b.
+// The number of columns of a must equal the number of rows of b, and both
+// matrices must be rectangular; otherwise MatrixMultiply returns an error
+// wrapping ErrDimensionMismatch that describes the problem.
+func MatrixMultiply(a, b Matrix) (Matrix, error) {
+	aCols, err := columns(a, "a")
+	if err != nil {
+		return nil, err
+	}
+	bCols, err := columns(b, "b")
+	if err != nil {
+		return nil, err
+	}
+	if aCols != len(b) {
+		return nil, fmt.Errorf("%w: a is %dx%d but b is %dx%d", ErrDimensionMismatch, len(a), aCols, len(b), bCols)
+	}
+
+	result := make(Matrix, len(a))
+	for i := range a {
+		result[i] = make([]float64, bCols)
+		for j := 0; j < bCols; j++ {
+			var sum float64
+			for k := 0; k < aCols; k++ {
+				sum += a[i][k] * b[k][j]
+			}
+			result[i][j] = sum
+		}
+	}
+	return result, nil
+}
+
+// Transpose returns a new matrix whose rows are the columns of m.
+// It returns an error wrapping ErrDimensionMismatch if m is not
+// rectangular.
+func Transpose(m Matrix) (Matrix, error) {
+	cols, err := columns(m, "m")
+	if err != nil {
+		return nil, err
+	}
+	result := make(Matrix, cols)
+	for j := range result {
+		result[j] = make([]float64, len(m))
+		for i := range m {
+			result[j][i] = m[i][j]
+		}
+	}
+	return result, nil
+}
+
+// columns returns the number of columns of m, or an error wrapping
+// ErrDimensionMismatch if its rows have different lengths.
+func columns(m Matrix, name string) (int, error) {
+	if len(m) == 0 {
+		return 0, nil
+	}
+	cols := len(m[0])
+	for i, row := range m {
+		if len(row) != cols {
+			return 0, fmt.Errorf("%w: row %d of %s has %d columns, want %d", ErrDimensionMismatch, i, name, len(row), cols)
+		}
+	}
+	return cols, nil
+}
@@ -0,0 +1,117 @@
+package mypackage
+
+import (
+	"errors"
+	"testing"
+)
+
+// equalMatrices reports whether a and b have the same shape and elements.
+func equalMatrices(a, b Matrix) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !equalSlices(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMatrixMultiply(t *testing.T) {
+	t.Run("2x3 times 3x2", func(t *testing.T) {
+		a := Matrix{
+			{1, 2, 3},
+			{4, 5, 6},
+		}
+		b := Matrix{
+			{7, 8},
+			{9, 10},
+			{11, 12},
+		}
+		expected := Matrix{
+			{58, 64},
+			{139, 154},
+		}
+
+		result, err := MatrixMultiply(a, b)
+		if err != nil {
+			t.Fatalf("MatrixMultiply() returned error: %v", err)
+		}
+		if !equalMatrices(result, expected) {
+			t.Errorf("MatrixMultiply() = %v; want %v", result, expected)
+		}
+	})
+
+	t.Run("identity", func(t *testing.T) {
+		m := Matrix{
+			{1, 2},
+			{3, 4},
+		}
+		identity := Matrix{
+			{1, 0},
+			{0, 1},
+		}
+
+		for _, order := range [][2]Matrix{{m, identity}, {identity, m}} {
+			result, err := MatrixMultiply(order[0], order[1])
+			if err != nil {
+				t.Fatalf("MatrixMultiply() returned error: %v", err)
+			}
+			if !equalMatrices(result, m) {
+				t.Errorf("MatrixMultiply(%v, %v) = %v; want %v", order[0], order[1], result, m)
+			}
+		}
+	})
+
+	t.Run("dimension mismatch", func(t *testing.T) {
+		a := Matrix{{1, 2, 3}}
+		b := Matrix{{1, 2}, {3, 4}}
+		if _, err := MatrixMultiply(a, b); !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("MatrixMultiply() error = %v; want %v", err, ErrDimensionMismatch)
+		}
+	})
+
+	t.Run("ragged matrix", func(t *testing.T) {
+		ragged := Matrix{{1, 2}, {3}}
+		square := Matrix{{1, 0}, {0, 1}}
+		if _, err := MatrixMultiply(ragged, square); !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("MatrixMultiply(ragged, square) error = %v; want %v", err, ErrDimensionMismatch)
+		}
+		if _, err := MatrixMultiply(square, ragged); !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("MatrixMultiply(square, ragged) error = %v; want %v", err, ErrDimensionMismatch)
+		}
+	})
+}
+
+func TestTranspose(t *testing.T) {
+	tests := []struct {
+		name     string
+		m        Matrix
+		expected Matrix
+	}{
+		{"2x3", Matrix{{1, 2, 3}, {4, 5, 6}}, Matrix{{1, 4}, {2, 5}, {3, 6}}},
+		{"square", Matrix{{1, 2}, {3, 4}}, Matrix{{1, 3}, {2, 4}}},
+		{"row vector", Matrix{{1, 2, 3}}, Matrix{{1}, {2}, {3}}},
+		{"empty", Matrix{}, Matrix{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Transpose(tt.m)
+			if err != nil {
+				t.Fatalf("Transpose(%v) returned error: %v", tt.m, err)
+			}
+			if !equalMatrices(result, tt.expected) {
+				t.Errorf("Transpose(%v) = %v; want %v", tt.m, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("ragged matrix", func(t *testing.T) {
+		ragged := Matrix{{1, 2}, {3}}
+		if _, err := Transpose(ragged); !errors.Is(err, ErrDimensionMismatch) {
+			t.Errorf("Transpose(%v) error = %v; want %v", ragged, err, ErrDimensionMismatch)
+		}
+	})
+}
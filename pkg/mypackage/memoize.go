@@ -0,0 +1,75 @@
+package mypackage
+
+import (
+	"sync"
+	"time"
+)
+
+// memoEntry holds a cached result. once ensures the result is computed a
+// single time even when several goroutines request the same key at once,
+// and done records whether that computation returned rather than panicked.
+type memoEntry[V any] struct {
+	once    sync.Once
+	done    bool
+	value   V
+	expires time.Time
+}
+
+// Memoize returns a function that caches the results of fn per argument,
+// so fn is called at most once for each distinct key. Concurrent calls
+// with the same key wait for the first computation instead of repeating it.
+// If fn panics, nothing is cached for that key: the panic propagates to the
+// caller and the next call with the key calls fn again.
+// The returned function is safe for concurrent use. The cache is never
+// evicted, so it grows with the number of distinct keys.
+func Memoize[K comparable, V any](fn func(K) V) func(K) V {
+	return memoize(fn, 0)
+}
+
+// MemoizeWithTTL is like Memoize, but each cached result expires ttl after
+// it was first requested; the next call with that key calls fn again.
+// Expired entries are replaced when their key is next requested.
+func MemoizeWithTTL[K comparable, V any](fn func(K) V, ttl time.Duration) func(K) V {
+	return memoize(fn, ttl)
+}
+
+// memoize implements Memoize and MemoizeWithTTL. A ttl of zero or less
+// means entries never expire.
+func memoize[K comparable, V any](fn func(K) V, ttl time.Duration) func(K) V {
+	var mu sync.Mutex
+	cache := make(map[K]*memoEntry[V])
+
+	return func(key K) V {
+		for {
+			now := time.Now()
+
+			mu.Lock()
+			entry, ok := cache[key]
+			if !ok || (ttl > 0 && !now.Before(entry.expires)) {
+				entry = &memoEntry[V]{expires: now.Add(ttl)}
+				cache[key] = entry
+			}
+			mu.Unlock()
+
+			entry.once.Do(func() {
+				defer func() {
+					if entry.done {
+						return
+					}
+					mu.Lock()
+					if cache[key] == entry {
+						delete(cache, key)
+					}
+					mu.Unlock()
+				}()
+				entry.value = fn(key)
+				entry.done = true
+			})
+			// Callers that waited on a computation that panicked try again
+			// with a fresh entry instead of returning its zero value.
+			if entry.done {
+				return entry.value
+			}
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package mypackage
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Run("calls fn once per key", func(t *testing.T) {
+		calls := make(map[int]int)
+		square := Memoize(func(n int) int {
+			calls[n]++
+			return n * n
+		})
+
+		for i := 0; i < 3; i++ {
+			for _, n := range []int{2, 3, 2, 4} {
+				if result := square(n); result != n*n {
+					t.Errorf("square(%d) = %d; want %d", n, result, n*n)
+				}
+			}
+		}
+
+		for _, n := range []int{2, 3, 4} {
+			if calls[n] != 1 {
+				t.Errorf("fn called %d times for key %d; want 1", calls[n], n)
+			}
+		}
+	})
+
+	t.Run("concurrent calls compute once", func(t *testing.T) {
+		var calls atomic.Int32
+		slow := Memoize(func(s string) int {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return len(s)
+		})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if result := slow("hello"); result != 5 {
+					t.Errorf("slow(hello) = %d; want 5", result)
+				}
+			}()
+		}
+		wg.Wait()
+
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn called %d times; want 1", n)
+		}
+	})
+
+	t.Run("panic is not cached", func(t *testing.T) {
+		calls := 0
+		flaky := Memoize(func(n int) int {
+			calls++
+			if calls == 1 {
+				panic("first call fails")
+			}
+			return n * 2
+		})
+
+		func() {
+			defer func() {
+				if r := recover(); r == nil {
+					t.Error("flaky(1) did not panic on the first call")
+				}
+			}()
+			flaky(1)
+		}()
+
+		if result := flaky(1); result != 2 {
+			t.Errorf("flaky(1) after panic = %d; want 2", result)
+		}
+		if result := flaky(1); result != 2 || calls != 2 {
+			t.Errorf("flaky(1) = %d after %d calls; want 2 after 2 calls", result, calls)
+		}
+	})
+}
+
+func TestMemoizeWithTTL(t *testing.T) {
+	t.Run("reuses result before expiry", func(t *testing.T) {
+		var calls atomic.Int32
+		fn := MemoizeWithTTL(func(n int) int {
+			calls.Add(1)
+			return n + 1
+		}, time.Minute)
+
+		for i := 0; i < 5; i++ {
+			if result := fn(1); result != 2 {
+				t.Errorf("fn(1) = %d; want 2", result)
+			}
+		}
+		if n := calls.Load(); n != 1 {
+			t.Errorf("fn called %d times; want 1", n)
+		}
+	})
+
+	t.Run("recomputes after expiry", func(t *testing.T) {
+		var calls atomic.Int32
+		fn := MemoizeWithTTL(func(n int) int {
+			return int(calls.Add(1))
+		}, 20*time.Millisecond)
+
+		first := fn(1)
+		if again := fn(1); again != first {
+			t.Errorf("fn(1) = %d before expiry; want cached %d", again, first)
+		}
+
+		time.Sleep(40 * time.Millisecond)
+
+		if recomputed := fn(1); recomputed == first {
+			t.Errorf("fn(1) = %d after expiry; want a recomputed value", recomputed)
+		}
+		if n := calls.Load(); n != 2 {
+			t.Errorf("fn called %d times; want 2", n)
+		}
+	})
+}
@@ -11,24 +11,45 @@ import (
 // This is used for release automation and changelog management.
 const Version = "0.1.0"
 
+// AddN returns the sum of two numbers of any Number type.
+func AddN[T Number](a, b T) T {
+	return a + b
+}
+
+// MultiplyN returns the product of two numbers of any Number type.
+func MultiplyN[T Number](a, b T) T {
+	return a * b
+}
+
 // Add returns the sum of two integers.
 func Add(a, b int) int {
-	return a + b
+	return AddN(a, b)
 }
 
 // AddFloat returns the sum of two float64 numbers.
 func AddFloat(a, b float64) float64 {
-	return a + b
+	return AddN(a, b)
+}
+
+// Subtract returns the difference of two integers.
+// Like the - operator, it wraps on overflow.
+func Subtract(a, b int) int {
+	return a - b
+}
+
+// SubtractFloat returns the difference of two float64 numbers.
+func SubtractFloat(a, b float64) float64 {
+	return a - b
 }
 
 // Multiply returns the product of two integers.
 func Multiply(a, b int) int {
-	return a * b
+	return MultiplyN(a, b)
 }
 
 // MultiplyFloat returns the product of two float64 numbers.
 func MultiplyFloat(a, b float64) float64 {
-	return a * b
+	return MultiplyN(a, b)
 }
 
 // Delay pauses execution for the specified duration.
@@ -42,6 +63,61 @@ func Delay(ctx context.Context, duration time.Duration) error {
 	}
 }
 
+// DelayUntil pauses execution until the specified time.
+// It returns nil immediately if t is not in the future, and otherwise
+// respects context cancellation the same way Delay does.
+func DelayUntil(ctx context.Context, t time.Time) error {
+	d := time.Until(t)
+	if d <= 0 {
+		return nil
+	}
+	return Delay(ctx, d)
+}
+
+// DelayFunc waits for the specified duration, then runs fn.
+// It respects context cancellation the same way Delay does: if the context
+// is cancelled before the duration elapses, fn is not run and DelayFunc
+// returns the context's error.
+func DelayFunc(ctx context.Context, duration time.Duration, fn func()) error {
+	if err := Delay(ctx, duration); err != nil {
+		return err
+	}
+	fn()
+	return nil
+}
+
+// DelayRemaining waits for d like Delay, and also reports how much of d was
+// left when it returned. It returns 0 and nil if the full duration elapsed,
+// or the time still remaining and ctx.Err() if the context was cancelled
+// first.
+func DelayRemaining(ctx context.Context, d time.Duration) (remaining time.Duration, err error) {
+	deadline := time.Now().Add(d)
+	if err := Delay(ctx, d); err != nil {
+		return max(time.Until(deadline), 0), err
+	}
+	return 0, nil
+}
+
+// DelayOrValue waits up to d for a value on ch. It returns the value and
+// true if one arrives in time, the zero value and false with a nil error if
+// d elapses first, or the zero value, false, and ctx.Err() if the context is
+// cancelled first. A closed ch counts as receiving the zero value with
+// false, without waiting for the timeout.
+func DelayOrValue[T any](ctx context.Context, d time.Duration, ch <-chan T) (T, bool, error) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	var zero T
+	select {
+	case v, ok := <-ch:
+		return v, ok, nil
+	case <-timer.C:
+		return zero, false, nil
+	case <-ctx.Done():
+		return zero, false, ctx.Err()
+	}
+}
+
 // DelaySimple pauses execution for the specified duration without context support.
 func DelaySimple(duration time.Duration) {
 	time.Sleep(duration)
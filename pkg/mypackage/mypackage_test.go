@@ -49,6 +49,51 @@ func TestAddFloat(t *testing.T) {
 	}
 }
 
+func TestSubtract(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{"positive numbers", 5, 3, 2},
+		{"with zero", 5, 0, 5},
+		{"from zero", 0, 5, -5},
+		{"negative numbers", -2, -3, 1},
+		{"mixed signs", -2, 5, -7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Subtract(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("Subtract(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestSubtractFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     float64
+		expected float64
+	}{
+		{"positive floats", 5.5, 2.0, 3.5},
+		{"with zero", 5.5, 0.0, 5.5},
+		{"negative floats", -2.5, -3.5, 1.0},
+		{"mixed signs", -2.5, 1.5, -4.0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SubtractFloat(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("SubtractFloat(%f, %f) = %f; want %f", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
 func TestMultiply(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -92,6 +137,36 @@ func TestMultiplyFloat(t *testing.T) {
 	}
 }
 
+func TestAddN(t *testing.T) {
+	if result := AddN(2, 3); result != 5 {
+		t.Errorf("AddN[int](2, 3) = %d; want 5", result)
+	}
+	if result := AddN(-7, 4); result != -3 {
+		t.Errorf("AddN[int](-7, 4) = %d; want -3", result)
+	}
+	if result := AddN[uint32](40, 2); result != 42 {
+		t.Errorf("AddN[uint32](40, 2) = %d; want 42", result)
+	}
+	if result := AddN[uint32](4294967295, 1); result != 0 {
+		t.Errorf("AddN[uint32](4294967295, 1) = %d; want 0", result)
+	}
+	if result := AddN[float32](1.5, 2.25); result != 3.75 {
+		t.Errorf("AddN[float32](1.5, 2.25) = %f; want 3.75", result)
+	}
+}
+
+func TestMultiplyN(t *testing.T) {
+	if result := MultiplyN(-4, 6); result != -24 {
+		t.Errorf("MultiplyN[int](-4, 6) = %d; want -24", result)
+	}
+	if result := MultiplyN[uint32](7, 6); result != 42 {
+		t.Errorf("MultiplyN[uint32](7, 6) = %d; want 42", result)
+	}
+	if result := MultiplyN[float32](2.5, 4); result != 10 {
+		t.Errorf("MultiplyN[float32](2.5, 4) = %f; want 10", result)
+	}
+}
+
 func TestDelay(t *testing.T) {
 	t.Run("completes after duration", func(t *testing.T) {
 		ctx := context.Background()
@@ -128,6 +203,189 @@ func TestDelay(t *testing.T) {
 	})
 }
 
+func TestDelayUntil(t *testing.T) {
+	t.Run("waits for near-future target", func(t *testing.T) {
+		target := time.Now().Add(50 * time.Millisecond)
+		err := DelayUntil(context.Background(), target)
+
+		if err != nil {
+			t.Errorf("DelayUntil() returned error: %v", err)
+		}
+		if time.Now().Before(target) {
+			t.Errorf("DelayUntil() returned before the target time")
+		}
+	})
+
+	t.Run("returns immediately for past target", func(t *testing.T) {
+		start := time.Now()
+		err := DelayUntil(context.Background(), start.Add(-time.Hour))
+		elapsed := time.Since(start)
+
+		if err != nil {
+			t.Errorf("DelayUntil() returned error: %v", err)
+		}
+		if elapsed >= 50*time.Millisecond {
+			t.Errorf("DelayUntil() should return immediately, took: %v", elapsed)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		start := time.Now()
+		err := DelayUntil(ctx, start.Add(time.Second))
+		elapsed := time.Since(start)
+
+		if err != context.Canceled {
+			t.Errorf("DelayUntil() should return context.Canceled, got: %v", err)
+		}
+		if elapsed >= 1*time.Second {
+			t.Errorf("DelayUntil() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+}
+
+func TestDelayFunc(t *testing.T) {
+	t.Run("runs fn after duration", func(t *testing.T) {
+		var ranAt time.Time
+		start := time.Now()
+		err := DelayFunc(context.Background(), 50*time.Millisecond, func() {
+			ranAt = time.Now()
+		})
+
+		if err != nil {
+			t.Errorf("DelayFunc() returned error: %v", err)
+		}
+		if ranAt.IsZero() {
+			t.Fatal("DelayFunc() did not run fn")
+		}
+		if elapsed := ranAt.Sub(start); elapsed < 50*time.Millisecond {
+			t.Errorf("DelayFunc() ran fn too early: %v", elapsed)
+		}
+	})
+
+	t.Run("cancellation prevents fn", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		called := false
+		err := DelayFunc(ctx, time.Second, func() { called = true })
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("DelayFunc() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if called {
+			t.Error("DelayFunc() ran fn after cancellation")
+		}
+	})
+
+	t.Run("zero duration", func(t *testing.T) {
+		called := false
+		err := DelayFunc(context.Background(), 0, func() { called = true })
+
+		if err != nil {
+			t.Errorf("DelayFunc() returned error: %v", err)
+		}
+		if !called {
+			t.Error("DelayFunc() did not run fn")
+		}
+	})
+}
+
+func TestDelayRemaining(t *testing.T) {
+	t.Run("full completion", func(t *testing.T) {
+		start := time.Now()
+		remaining, err := DelayRemaining(context.Background(), 30*time.Millisecond)
+		if err != nil || remaining != 0 {
+			t.Errorf("DelayRemaining() = %v, %v; want 0, nil", remaining, err)
+		}
+		if elapsed := time.Since(start); elapsed < 30*time.Millisecond {
+			t.Errorf("DelayRemaining() returned after %v; want at least 30ms", elapsed)
+		}
+	})
+
+	t.Run("cancelled partway through", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		remaining, err := DelayRemaining(ctx, time.Second)
+		if err != context.DeadlineExceeded {
+			t.Errorf("DelayRemaining() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if remaining <= 0 || remaining > 980*time.Millisecond {
+			t.Errorf("DelayRemaining() remaining = %v; want about 980ms", remaining)
+		}
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		remaining, err := DelayRemaining(ctx, time.Hour)
+		if err != context.Canceled {
+			t.Errorf("DelayRemaining() should return context.Canceled, got: %v", err)
+		}
+		if remaining < 59*time.Minute {
+			t.Errorf("DelayRemaining() remaining = %v; want nearly the full hour", remaining)
+		}
+	})
+}
+
+func TestDelayOrValue(t *testing.T) {
+	t.Run("receives before timeout", func(t *testing.T) {
+		ch := make(chan int, 1)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			ch <- 42
+		}()
+
+		v, ok, err := DelayOrValue(context.Background(), time.Second, ch)
+		if v != 42 || !ok || err != nil {
+			t.Errorf("DelayOrValue() = %d, %v, %v; want 42, true, nil", v, ok, err)
+		}
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		start := time.Now()
+		v, ok, err := DelayOrValue(context.Background(), 20*time.Millisecond, make(chan string))
+		if v != "" || ok || err != nil {
+			t.Errorf("DelayOrValue() = %q, %v, %v; want \"\", false, nil", v, ok, err)
+		}
+		if elapsed := time.Since(start); elapsed < 20*time.Millisecond {
+			t.Errorf("DelayOrValue() returned after %v; want at least 20ms", elapsed)
+		}
+	})
+
+	t.Run("cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		v, ok, err := DelayOrValue(ctx, time.Second, make(chan int))
+		if v != 0 || ok || err != context.DeadlineExceeded {
+			t.Errorf("DelayOrValue() = %d, %v, %v; want 0, false, %v", v, ok, err, context.DeadlineExceeded)
+		}
+	})
+
+	t.Run("closed channel", func(t *testing.T) {
+		ch := make(chan int)
+		close(ch)
+
+		start := time.Now()
+		v, ok, err := DelayOrValue(context.Background(), time.Second, ch)
+		if v != 0 || ok || err != nil {
+			t.Errorf("DelayOrValue() = %d, %v, %v; want 0, false, nil", v, ok, err)
+		}
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("DelayOrValue() waited %v on a closed channel", elapsed)
+		}
+	})
+}
+
 func TestDelaySimple(t *testing.T) {
 	start := time.Now()
 	DelaySimple(50 * time.Millisecond)
@@ -0,0 +1,241 @@
+package mypackage
+
+import (
+	"math"
+	"math/big"
+	"math/bits"
+)
+
+// absUint returns the absolute value of a as a uint.
+// Unlike AbsInt, it is exact for math.MinInt.
+func absUint(a int) uint {
+	if a < 0 {
+		return uint(-a)
+	}
+	return uint(a)
+}
+
+// gcdUint returns the greatest common divisor of a and b using the
+// Euclidean algorithm.
+func gcdUint(a, b uint) uint {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// GCD returns the greatest common divisor of a and b using the Euclidean
+// algorithm. It operates on absolute values, so the result is never
+// negative, and GCD(a, 0) is |a|. GCD(0, 0) is 0.
+// The only result that does not fit in an int is 2^63 (for example
+// GCD(math.MinInt, 0)), which wraps around to math.MinInt.
+func GCD(a, b int) int {
+	return int(gcdUint(absUint(a), absUint(b)))
+}
+
+// LCM returns the least common multiple of a and b, which is never
+// negative. LCM(a, 0) is 0. It returns ErrOverflow if the result cannot
+// be represented as an int.
+func LCM(a, b int) (int, error) {
+	if a == 0 || b == 0 {
+		return 0, nil
+	}
+	x, y := absUint(a), absUint(b)
+	x /= gcdUint(x, y)
+	if x > math.MaxInt/y {
+		return 0, &ArithmeticError{Op: "LCM", Err: ErrOverflow}
+	}
+	return int(x * y), nil
+}
+
+// ModPow returns base^exp mod |mod| using exponentiation by squaring.
+// The result is always in the range [0, |mod|), so a negative base is
+// reduced the same way as EuclideanMod. Intermediate products are computed
+// in 128 bits, so no step overflows. ModPow(base, 0, mod) is 1 mod |mod|.
+// It returns ErrDivideByZero if mod is zero and ErrNegative if exp is
+// negative.
+func ModPow(base, exp, mod int) (int, error) {
+	if mod == 0 {
+		return 0, &ArithmeticError{Op: "ModPow", Err: ErrDivideByZero}
+	}
+	if exp < 0 {
+		return 0, &ArithmeticError{Op: "ModPow", Err: ErrNegative}
+	}
+	reduced, _ := EuclideanMod(base, mod)
+	m := uint64(absUint(mod))
+	b := uint64(reduced)
+	result := 1 % m
+	for e := uint(exp); e > 0; e >>= 1 {
+		if e&1 == 1 {
+			result = mulMod(result, b, m)
+		}
+		b = mulMod(b, b, m)
+	}
+	return int(result), nil
+}
+
+// mulMod returns a*b mod m without overflowing, for a, b < m.
+func mulMod(a, b, m uint64) uint64 {
+	hi, lo := bits.Mul64(a, b)
+	return bits.Rem64(hi, lo, m)
+}
+
+// ExtGCD returns the greatest common divisor of a and b together with
+// Bézout coefficients x and y such that a*x + b*y == gcd, using the
+// extended Euclidean algorithm. As with GCD the result is never negative,
+// and ExtGCD(0, 0) is (0, 0, 0). Inputs of math.MinInt may overflow.
+func ExtGCD(a, b int) (gcd, x, y int) {
+	oldR, r := a, b
+	oldX, x := 1, 0
+	oldY, y := 0, 1
+	for r != 0 {
+		q := oldR / r
+		oldR, r = r, oldR-q*r
+		oldX, x = x, oldX-q*x
+		oldY, y = y, oldY-q*y
+	}
+	if oldR < 0 {
+		return -oldR, -oldX, -oldY
+	}
+	return oldR, oldX, oldY
+}
+
+// ModInverse returns the x in [0, |mod|) for which a*x mod |mod| is 1.
+// It returns ErrDivideByZero if mod is zero and ErrNoInverse if a and mod
+// are not coprime.
+func ModInverse(a, mod int) (int, error) {
+	if mod == 0 {
+		return 0, &ArithmeticError{Op: "ModInverse", Err: ErrDivideByZero}
+	}
+	g, x, _ := ExtGCD(a, mod)
+	if g != 1 {
+		return 0, &ArithmeticError{Op: "ModInverse", Err: ErrNoInverse}
+	}
+	return EuclideanMod(x, mod)
+}
+
+// Factorial returns n! computed iteratively.
+// It returns ErrNegative if n is negative and ErrOverflow if the result
+// cannot be represented as an int; on 64-bit platforms 20! is the largest
+// factorial that fits.
+func Factorial(n int) (int, error) {
+	if n < 0 {
+		return 0, &ArithmeticError{Op: "Factorial", Err: ErrNegative}
+	}
+	result := 1
+	for i := 2; i <= n; i++ {
+		var err error
+		if result, err = multiplyChecked(result, i); err != nil {
+			return 0, &ArithmeticError{Op: "Factorial", Err: err}
+		}
+	}
+	return result, nil
+}
+
+// FactorialBig returns n! as an arbitrary-precision integer.
+// It returns ErrNegative if n is negative.
+func FactorialBig(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, &ArithmeticError{Op: "FactorialBig", Err: ErrNegative}
+	}
+	return new(big.Int).MulRange(1, int64(n)), nil
+}
+
+// Fibonacci returns the nth Fibonacci number, where Fibonacci(0) is 0 and
+// Fibonacci(1) is 1. It is computed iteratively.
+// It returns ErrNegative if n is negative and ErrOverflow if the result
+// cannot be represented as an int; on 64-bit platforms Fibonacci(92) is
+// the largest term that fits.
+func Fibonacci(n int) (int, error) {
+	if n < 0 {
+		return 0, &ArithmeticError{Op: "Fibonacci", Err: ErrNegative}
+	}
+	a, b := 0, 1
+	for i := 0; i < n; i++ {
+		next, err := AddChecked(a, b)
+		if err != nil && i < n-1 {
+			return 0, &ArithmeticError{Op: "Fibonacci", Err: err}
+		}
+		a, b = b, next
+	}
+	return a, nil
+}
+
+// FibonacciSeq returns the first n Fibonacci numbers, starting with 0.
+// It returns an empty slice for n == 0, ErrNegative if n is negative, and
+// ErrOverflow if the last term cannot be represented as an int.
+func FibonacciSeq(n int) ([]int, error) {
+	if n < 0 {
+		return nil, &ArithmeticError{Op: "FibonacciSeq", Err: ErrNegative}
+	}
+	if n > 0 {
+		if _, err := Fibonacci(n - 1); err != nil {
+			return nil, &ArithmeticError{Op: "FibonacciSeq", Err: err}
+		}
+	}
+	seq := make([]int, n)
+	for i := range seq {
+		if i < 2 {
+			seq[i] = i
+			continue
+		}
+		seq[i] = seq[i-1] + seq[i-2]
+	}
+	return seq, nil
+}
+
+// FibonacciBig returns the nth Fibonacci number as an arbitrary-precision
+// integer. It returns ErrNegative if n is negative.
+func FibonacciBig(n int) (*big.Int, error) {
+	if n < 0 {
+		return nil, &ArithmeticError{Op: "FibonacciBig", Err: ErrNegative}
+	}
+	a, b := big.NewInt(0), big.NewInt(1)
+	for i := 0; i < n; i++ {
+		a.Add(a, b)
+		a, b = b, a
+	}
+	return a, nil
+}
+
+// IsPrime reports whether n is a prime number.
+// It uses trial division up to the square root of n, skipping multiples
+// of 2 and 3. IsPrime returns false for any n less than 2.
+func IsPrime(n int) bool {
+	if n < 2 {
+		return false
+	}
+	if n < 4 {
+		return true
+	}
+	if n%2 == 0 || n%3 == 0 {
+		return false
+	}
+	for i := 5; i <= n/i; i += 6 {
+		if n%i == 0 || n%(i+2) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// PrimesUpTo returns all primes less than or equal to limit in ascending
+// order, using the Sieve of Eratosthenes. It returns an empty slice if
+// limit is less than 2.
+func PrimesUpTo(limit int) []int {
+	if limit < 2 {
+		return []int{}
+	}
+	composite := make([]bool, limit+1)
+	primes := []int{}
+	for i := 2; i <= limit; i++ {
+		if composite[i] {
+			continue
+		}
+		primes = append(primes, i)
+		for j := i * i; j <= limit && j > 0; j += i {
+			composite[j] = true
+		}
+	}
+	return primes
+}
@@ -0,0 +1,461 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"math/bits"
+	"testing"
+)
+
+func TestGCD(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{"coprime", 9, 28, 1},
+		{"common factor", 12, 18, 6},
+		{"multiple", 7, 21, 7},
+		{"equal", 5, 5, 5},
+		{"zero and value", 0, 9, 9},
+		{"value and zero", 9, 0, 9},
+		{"both zero", 0, 0, 0},
+		{"negative first", -12, 18, 6},
+		{"both negative", -12, -18, 6},
+		{"min int and max int", math.MinInt, math.MaxInt, 1},
+		{"min int and power of two", math.MinInt, 1 << (bits.UintSize - 2), 1 << (bits.UintSize - 2)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := GCD(tt.a, tt.b)
+			if result != tt.expected {
+				t.Errorf("GCD(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestLCM(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+		err      error
+	}{
+		{"coprime", 4, 9, 36, nil},
+		{"common factor", 4, 6, 12, nil},
+		{"multiple", 7, 21, 21, nil},
+		{"zero", 0, 5, 0, nil},
+		{"both zero", 0, 0, 0, nil},
+		{"negative", -4, 6, 12, nil},
+		{"both negative", -4, -6, 12, nil},
+		{"large without overflow", math.MaxInt, 1, math.MaxInt, nil},
+		{"overflow", math.MaxInt, 2, 0, ErrOverflow},
+		{"overflow for large coprimes", 1 << (bits.UintSize / 2), 1<<(bits.UintSize/2) - 1, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := LCM(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("LCM(%d, %d) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("LCM(%d, %d) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestModPow(t *testing.T) {
+	tests := []struct {
+		name           string
+		base, exp, mod int
+		expected       int
+		err            error
+	}{
+		{"known value", 2, 10, 1000, 24, nil},
+		{"fermat little theorem", 3, 12, 13, 1, nil},
+		{"zero exponent", 7, 0, 5, 1, nil},
+		{"zero exponent mod one", 7, 0, 1, 0, nil},
+		{"zero base", 0, 5, 7, 0, nil},
+		{"negative base", -2, 3, 5, 2, nil},
+		{"negative modulus", 2, 10, -1000, 24, nil},
+		{"large operands", math.MaxInt, 2, math.MaxInt - 1, 1, nil},
+		{"zero modulus", 2, 10, 0, 0, ErrDivideByZero},
+		{"negative exponent", 2, -1, 7, 0, ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ModPow(tt.base, tt.exp, tt.mod)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ModPow(%d, %d, %d) error = %v; want %v", tt.base, tt.exp, tt.mod, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ModPow(%d, %d, %d) = %d; want %d", tt.base, tt.exp, tt.mod, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("matches big.Int", func(t *testing.T) {
+		cases := [][3]int{
+			{123456789, 987654321, 1_000_000_007},
+			{math.MaxInt - 5, 65537, math.MaxInt},
+			{-987654321, 31, 1<<31 - 1},
+		}
+		for _, c := range cases {
+			result, err := ModPow(c[0], c[1], c[2])
+			if err != nil {
+				t.Fatalf("ModPow(%d, %d, %d) returned error: %v", c[0], c[1], c[2], err)
+			}
+			m := big.NewInt(int64(c[2]))
+			b := new(big.Int).Mod(big.NewInt(int64(c[0])), m)
+			expected := new(big.Int).Exp(b, big.NewInt(int64(c[1])), m)
+			if int64(result) != expected.Int64() {
+				t.Errorf("ModPow(%d, %d, %d) = %d; want %s", c[0], c[1], c[2], result, expected)
+			}
+		}
+	})
+}
+
+func TestExtGCD(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     int
+		expected int
+	}{
+		{"textbook example", 240, 46, 2},
+		{"coprime", 17, 5, 1},
+		{"multiple", 12, 36, 12},
+		{"zero a", 0, 9, 9},
+		{"zero b", 9, 0, 9},
+		{"both zero", 0, 0, 0},
+		{"negative a", -240, 46, 2},
+		{"both negative", -12, -18, 6},
+		{"large", math.MaxInt, math.MaxInt - 1, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gcd, x, y := ExtGCD(tt.a, tt.b)
+			if gcd != tt.expected {
+				t.Errorf("ExtGCD(%d, %d) gcd = %d; want %d", tt.a, tt.b, gcd, tt.expected)
+			}
+			if gcd != GCD(tt.a, tt.b) {
+				t.Errorf("ExtGCD(%d, %d) gcd = %d; GCD returns %d", tt.a, tt.b, gcd, GCD(tt.a, tt.b))
+			}
+			if tt.a*x+tt.b*y != gcd {
+				t.Errorf("ExtGCD(%d, %d) = (%d, %d, %d); %d*%d + %d*%d != %d", tt.a, tt.b, gcd, x, y, tt.a, x, tt.b, y, gcd)
+			}
+		})
+	}
+}
+
+func TestModInverse(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, mod   int
+		expected int
+		err      error
+	}{
+		{"known inverse", 3, 11, 4, nil},
+		{"another inverse", 10, 17, 12, nil},
+		{"negative a", -3, 11, 7, nil},
+		{"a larger than mod", 14, 11, 4, nil},
+		{"negative modulus", 3, -11, 4, nil},
+		{"mod one", 5, 1, 0, nil},
+		{"not coprime", 6, 9, 0, ErrNoInverse},
+		{"zero a", 0, 7, 0, ErrNoInverse},
+		{"zero modulus", 3, 0, 0, ErrDivideByZero},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ModInverse(tt.a, tt.mod)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ModInverse(%d, %d) error = %v; want %v", tt.a, tt.mod, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ModInverse(%d, %d) = %d; want %d", tt.a, tt.mod, result, tt.expected)
+			}
+			if tt.err == nil {
+				if product, _ := EuclideanMod(tt.a*result, tt.mod); product != 1%AbsInt(tt.mod) {
+					t.Errorf("ModInverse(%d, %d) = %d; a*x mod m = %d, want 1", tt.a, tt.mod, result, product)
+				}
+			}
+		})
+	}
+}
+
+func TestFactorial(t *testing.T) {
+	// The largest factorial that fits depends on the width of int.
+	largestN, largest := 12, int64(479001600)
+	if bits.UintSize == 64 {
+		largestN, largest = 20, 2432902008176640000
+	}
+
+	tests := []struct {
+		name     string
+		n        int
+		expected int64
+		err      error
+	}{
+		{"zero", 0, 1, nil},
+		{"one", 1, 1, nil},
+		{"small value", 5, 120, nil},
+		{"ten", 10, 3628800, nil},
+		{"largest representable", largestN, largest, nil},
+		{"overflow", largestN + 1, 0, ErrOverflow},
+		{"negative", -1, 0, ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Factorial(tt.n)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Factorial(%d) error = %v; want %v", tt.n, err, tt.err)
+			}
+			if int64(result) != tt.expected {
+				t.Errorf("Factorial(%d) = %d; want %d", tt.n, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFactorialBig(t *testing.T) {
+	tests := []struct {
+		name     string
+		n        int
+		expected string
+	}{
+		{"zero", 0, "1"},
+		{"one", 1, "1"},
+		{"matches Factorial", 20, "2432902008176640000"},
+		{"beyond int64", 21, "51090942171709440000"},
+		{"large", 30, "265252859812191058636308480000000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := FactorialBig(tt.n)
+			if err != nil {
+				t.Fatalf("FactorialBig(%d) returned error: %v", tt.n, err)
+			}
+			if result.String() != tt.expected {
+				t.Errorf("FactorialBig(%d) = %s; want %s", tt.n, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("negative", func(t *testing.T) {
+		if _, err := FactorialBig(-1); !errors.Is(err, ErrNegative) {
+			t.Errorf("FactorialBig(-1) error = %v; want %v", err, ErrNegative)
+		}
+	})
+}
+
+// largestFibonacci returns the index and value of the largest Fibonacci
+// number that fits in an int, which depends on the width of int.
+func largestFibonacci() (int, int64) {
+	if bits.UintSize == 64 {
+		return 92, 7540113804746346429
+	}
+	return 46, 1836311903
+}
+
+func TestFibonacci(t *testing.T) {
+	expected := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+	for n, want := range expected {
+		result, err := Fibonacci(n)
+		if err != nil {
+			t.Fatalf("Fibonacci(%d) returned error: %v", n, err)
+		}
+		if result != want {
+			t.Errorf("Fibonacci(%d) = %d; want %d", n, result, want)
+		}
+	}
+
+	n, largest := largestFibonacci()
+
+	t.Run("largest representable", func(t *testing.T) {
+		result, err := Fibonacci(n)
+		if err != nil || int64(result) != largest {
+			t.Errorf("Fibonacci(%d) = %d, %v; want %d, nil", n, result, err, largest)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		if _, err := Fibonacci(n + 1); !errors.Is(err, ErrOverflow) {
+			t.Errorf("Fibonacci(%d) error = %v; want %v", n+1, err, ErrOverflow)
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		if _, err := Fibonacci(-1); !errors.Is(err, ErrNegative) {
+			t.Errorf("Fibonacci(-1) error = %v; want %v", err, ErrNegative)
+		}
+	})
+}
+
+func TestFibonacciSeq(t *testing.T) {
+	t.Run("first dozen terms", func(t *testing.T) {
+		expected := []int{0, 1, 1, 2, 3, 5, 8, 13, 21, 34, 55, 89}
+		result, err := FibonacciSeq(12)
+		if err != nil {
+			t.Fatalf("FibonacciSeq(12) returned error: %v", err)
+		}
+		if len(result) != len(expected) {
+			t.Fatalf("FibonacciSeq(12) returned %d terms; want %d", len(result), len(expected))
+		}
+		for i := range expected {
+			if result[i] != expected[i] {
+				t.Errorf("FibonacciSeq(12)[%d] = %d; want %d", i, result[i], expected[i])
+			}
+		}
+	})
+
+	t.Run("zero terms", func(t *testing.T) {
+		result, err := FibonacciSeq(0)
+		if err != nil || len(result) != 0 {
+			t.Errorf("FibonacciSeq(0) = %v, %v; want [], nil", result, err)
+		}
+	})
+
+	t.Run("single term", func(t *testing.T) {
+		result, err := FibonacciSeq(1)
+		if err != nil || len(result) != 1 || result[0] != 0 {
+			t.Errorf("FibonacciSeq(1) = %v, %v; want [0], nil", result, err)
+		}
+	})
+
+	t.Run("largest representable", func(t *testing.T) {
+		n, largest := largestFibonacci()
+		result, err := FibonacciSeq(n + 1)
+		if err != nil {
+			t.Fatalf("FibonacciSeq(%d) returned error: %v", n+1, err)
+		}
+		if int64(result[n]) != largest {
+			t.Errorf("FibonacciSeq(%d) last term = %d; want %d", n+1, result[n], largest)
+		}
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		n, _ := largestFibonacci()
+		if _, err := FibonacciSeq(n + 2); !errors.Is(err, ErrOverflow) {
+			t.Errorf("FibonacciSeq(%d) error = %v; want %v", n+2, err, ErrOverflow)
+		}
+	})
+
+	t.Run("negative", func(t *testing.T) {
+		if _, err := FibonacciSeq(-1); !errors.Is(err, ErrNegative) {
+			t.Errorf("FibonacciSeq(-1) error = %v; want %v", err, ErrNegative)
+		}
+	})
+}
+
+func TestFibonacciBig(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{11, "89"},
+		{92, "7540113804746346429"},
+		{93, "12200160415121876738"},
+		{100, "354224848179261915075"},
+	}
+
+	for _, tt := range tests {
+		result, err := FibonacciBig(tt.n)
+		if err != nil {
+			t.Fatalf("FibonacciBig(%d) returned error: %v", tt.n, err)
+		}
+		if result.String() != tt.expected {
+			t.Errorf("FibonacciBig(%d) = %s; want %s", tt.n, result, tt.expected)
+		}
+	}
+
+	if _, err := FibonacciBig(-1); !errors.Is(err, ErrNegative) {
+		t.Errorf("FibonacciBig(-1) error = %v; want %v", err, ErrNegative)
+	}
+}
+
+func TestIsPrime(t *testing.T) {
+	tests := []struct {
+		n        int
+		expected bool
+	}{
+		{math.MinInt, false},
+		{-7, false},
+		{0, false},
+		{1, false},
+		{2, true},
+		{3, true},
+		{4, false},
+		{9, false},
+		{25, false},
+		{29, true},
+		{49, false},
+		{97, true},
+		{7919, true},
+		{7917, false},
+		{2147483647, true},
+		{46337 * 46337, false},
+	}
+
+	for _, tt := range tests {
+		if result := IsPrime(tt.n); result != tt.expected {
+			t.Errorf("IsPrime(%d) = %v; want %v", tt.n, result, tt.expected)
+		}
+	}
+}
+
+func TestPrimesUpTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		limit    int
+		expected []int
+	}{
+		{"thirty", 30, []int{2, 3, 5, 7, 11, 13, 17, 19, 23, 29}},
+		{"limit is prime", 13, []int{2, 3, 5, 7, 11, 13}},
+		{"two", 2, []int{2}},
+		{"one", 1, []int{}},
+		{"negative", -10, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PrimesUpTo(tt.limit)
+			if result == nil {
+				t.Fatalf("PrimesUpTo(%d) returned nil; want non-nil slice", tt.limit)
+			}
+			if len(result) != len(tt.expected) {
+				t.Fatalf("PrimesUpTo(%d) = %v; want %v", tt.limit, result, tt.expected)
+			}
+			for i := range tt.expected {
+				if result[i] != tt.expected[i] {
+					t.Errorf("PrimesUpTo(%d) = %v; want %v", tt.limit, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+
+	t.Run("agrees with IsPrime", func(t *testing.T) {
+		primes := PrimesUpTo(1000)
+		next := 0
+		for n := 0; n <= 1000; n++ {
+			isListed := next < len(primes) && primes[next] == n
+			if isListed {
+				next++
+			}
+			if isListed != IsPrime(n) {
+				t.Errorf("PrimesUpTo(1000) and IsPrime disagree on %d", n)
+			}
+		}
+	})
+}
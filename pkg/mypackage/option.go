@@ -0,0 +1,40 @@
+package mypackage
+
+// Option holds either a value or nothing. The zero Option is None.
+type Option[T any] struct {
+	value T
+	ok    bool
+}
+
+// Some returns an Option holding v.
+func Some[T any](v T) Option[T] {
+	return Option[T]{value: v, ok: true}
+}
+
+// None returns an Option holding nothing.
+func None[T any]() Option[T] {
+	return Option[T]{}
+}
+
+// Get returns the value held by o and true, or the zero value and false
+// if o is None.
+func (o Option[T]) Get() (T, bool) {
+	return o.value, o.ok
+}
+
+// OrElse returns the value held by o, or fallback if o is None.
+func (o Option[T]) OrElse(fallback T) T {
+	if !o.ok {
+		return fallback
+	}
+	return o.value
+}
+
+// IndexOfOption is IndexOf returning None, rather than -1, when target is
+// not present.
+func IndexOfOption[T comparable](items []T, target T) Option[int] {
+	if i := IndexOf(items, target); i >= 0 {
+		return Some(i)
+	}
+	return None[int]()
+}
@@ -0,0 +1,59 @@
+package mypackage
+
+import "testing"
+
+func TestOption(t *testing.T) {
+	t.Run("some", func(t *testing.T) {
+		o := Some(7)
+		if v, ok := o.Get(); v != 7 || !ok {
+			t.Errorf("Some(7).Get() = %d, %v; want 7, true", v, ok)
+		}
+		if v := o.OrElse(-1); v != 7 {
+			t.Errorf("Some(7).OrElse(-1) = %d; want 7", v)
+		}
+	})
+
+	t.Run("some holding the zero value", func(t *testing.T) {
+		o := Some("")
+		if v, ok := o.Get(); v != "" || !ok {
+			t.Errorf("Some(\"\").Get() = %q, %v; want \"\", true", v, ok)
+		}
+		if v := o.OrElse("fallback"); v != "" {
+			t.Errorf("Some(\"\").OrElse(\"fallback\") = %q; want \"\"", v)
+		}
+	})
+
+	t.Run("none", func(t *testing.T) {
+		o := None[int]()
+		if v, ok := o.Get(); v != 0 || ok {
+			t.Errorf("None().Get() = %d, %v; want 0, false", v, ok)
+		}
+		if v := o.OrElse(-1); v != -1 {
+			t.Errorf("None().OrElse(-1) = %d; want -1", v)
+		}
+	})
+
+	t.Run("zero value is none", func(t *testing.T) {
+		var o Option[float64]
+		if _, ok := o.Get(); ok {
+			t.Error("zero Option.Get() reported a value; want none")
+		}
+	})
+}
+
+func TestIndexOfOption(t *testing.T) {
+	items := []string{"a", "b", "c"}
+
+	if i, ok := IndexOfOption(items, "c").Get(); i != 2 || !ok {
+		t.Errorf("IndexOfOption(%q, \"c\").Get() = %d, %v; want 2, true", items, i, ok)
+	}
+	if i, ok := IndexOfOption(items, "a").Get(); i != 0 || !ok {
+		t.Errorf("IndexOfOption(%q, \"a\").Get() = %d, %v; want 0, true", items, i, ok)
+	}
+	if _, ok := IndexOfOption(items, "z").Get(); ok {
+		t.Errorf("IndexOfOption(%q, \"z\") found a value; want none", items)
+	}
+	if i := IndexOfOption[string](nil, "a").OrElse(-1); i != -1 {
+		t.Errorf("IndexOfOption(nil, \"a\").OrElse(-1) = %d; want -1", i)
+	}
+}
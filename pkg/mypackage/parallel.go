@@ -0,0 +1,198 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ParallelMap applies fn to every item using the given number of worker
+// goroutines and returns the results in the same order as items.
+// If fn returns an error, the context passed to the remaining calls is
+// cancelled, no further items are dispatched, and ParallelMap returns the
+// first error. If ctx is cancelled, ParallelMap stops dispatching items and
+// returns ctx.Err(). A workers value less than one is treated as one, and
+// never more workers than items are started.
+func ParallelMap[T, R any](ctx context.Context, items []T, workers int, fn func(context.Context, T) (R, error)) ([]R, error) {
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if workers < 1 {
+		workers = 1
+	}
+	if workers > len(items) {
+		workers = len(items)
+	}
+
+	results := make([]R, len(items))
+	jobs := make(chan int)
+
+	var (
+		wg       sync.WaitGroup
+		errOnce  sync.Once
+		firstErr error
+	)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				r, err := fn(ctx, items[i])
+				if err != nil {
+					errOnce.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					continue
+				}
+				results[i] = r
+			}
+		}()
+	}
+
+dispatch:
+	for i := range items {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if err := parent.Err(); err != nil {
+		return nil, err
+	}
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
+
+// ForEach calls fn for every item, with at most concurrency calls running
+// at the same time. A concurrency value less than one is treated as one.
+// Unlike ParallelMap, an error from fn does not stop the remaining items;
+// all errors are combined with errors.Join. When ctx is cancelled, ForEach
+// stops dispatching new items, waits for the running calls, and includes
+// ctx.Err() in the returned error.
+func ForEach[T any](ctx context.Context, items []T, concurrency int, fn func(context.Context, T) error) error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, concurrency)
+
+dispatch:
+	for _, item := range items {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			break dispatch
+		}
+		// Both cases may be ready at once, so check again before dispatching.
+		if ctx.Err() != nil {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(item T) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := fn(ctx, item); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// Race runs every fn concurrently and returns nil as soon as one of them
+// succeeds, cancelling the context passed to the others. If all of them
+// fail it returns their errors combined with errors.Join, in the order they
+// finished. If ctx is cancelled first, Race returns ctx.Err() at once.
+// Race does not wait for the cancelled calls to return. It returns
+// ErrEmptyInput if fns is empty.
+func Race(ctx context.Context, fns ...func(context.Context) error) error {
+	if len(fns) == 0 {
+		return ErrEmptyInput
+	}
+	parent := ctx
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan error, len(fns))
+	for _, fn := range fns {
+		go func(fn func(context.Context) error) {
+			results <- fn(ctx)
+		}(fn)
+	}
+
+	errs := make([]error, 0, len(fns))
+	for range fns {
+		select {
+		case err := <-results:
+			if err == nil {
+				return nil
+			}
+			errs = append(errs, err)
+		case <-parent.Done():
+			return parent.Err()
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// Merge forwards every value received on chans to a single output channel,
+// in no particular order. The output is closed once every input has been
+// closed and drained, or as soon as ctx is cancelled, in which case values
+// not yet forwarded are dropped. Nil input channels are ignored, and with
+// no inputs the output is closed immediately.
+func Merge[T any](ctx context.Context, chans ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range chans {
+		if ch == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(ch <-chan T) {
+			defer wg.Done()
+			for {
+				select {
+				case v, ok := <-ch:
+					if !ok {
+						return
+					}
+					select {
+					case out <- v:
+					case <-ctx.Done():
+						return
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}(ch)
+	}
+
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
@@ -0,0 +1,365 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestParallelMap(t *testing.T) {
+	square := func(ctx context.Context, n int) (int, error) {
+		return n * n, nil
+	}
+
+	t.Run("preserves order", func(t *testing.T) {
+		items := make([]int, 100)
+		for i := range items {
+			items[i] = i
+		}
+		// Later items finish first to shuffle completion order.
+		fn := func(ctx context.Context, n int) (int, error) {
+			time.Sleep(time.Duration(100-n) * 10 * time.Microsecond)
+			return n * n, nil
+		}
+
+		results, err := ParallelMap(context.Background(), items, 8, fn)
+		if err != nil {
+			t.Fatalf("ParallelMap() returned error: %v", err)
+		}
+		if len(results) != len(items) {
+			t.Fatalf("ParallelMap() returned %d results; want %d", len(results), len(items))
+		}
+		for i, r := range results {
+			if r != i*i {
+				t.Errorf("results[%d] = %d; want %d", i, r, i*i)
+			}
+		}
+	})
+
+	t.Run("more workers than items", func(t *testing.T) {
+		results, err := ParallelMap(context.Background(), []int{1, 2, 3}, 50, square)
+		if err != nil {
+			t.Fatalf("ParallelMap() returned error: %v", err)
+		}
+		expected := []int{1, 4, 9}
+		for i := range expected {
+			if results[i] != expected[i] {
+				t.Errorf("results[%d] = %d; want %d", i, results[i], expected[i])
+			}
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		results, err := ParallelMap(context.Background(), []int{}, 4, square)
+		if err != nil || len(results) != 0 {
+			t.Errorf("ParallelMap([]) = %v, %v; want [], nil", results, err)
+		}
+	})
+
+	t.Run("error short-circuits", func(t *testing.T) {
+		errBad := errors.New("bad item")
+		var calls atomic.Int32
+		items := make([]int, 1000)
+		for i := range items {
+			items[i] = i
+		}
+		fn := func(ctx context.Context, n int) (int, error) {
+			calls.Add(1)
+			if n == 3 {
+				return 0, errBad
+			}
+			if err := Delay(ctx, time.Millisecond); err != nil {
+				return 0, err
+			}
+			return n, nil
+		}
+
+		results, err := ParallelMap(context.Background(), items, 2, fn)
+		if !errors.Is(err, errBad) {
+			t.Errorf("ParallelMap() should return the first error, got: %v", err)
+		}
+		if results != nil {
+			t.Errorf("ParallelMap() results = %v; want nil on error", results)
+		}
+		if n := calls.Load(); n >= int32(len(items)) {
+			t.Errorf("ParallelMap() processed all %d items after an error", n)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		items := make([]int, 100)
+		fn := func(ctx context.Context, n int) (int, error) {
+			return n, Delay(ctx, 10*time.Millisecond)
+		}
+
+		start := time.Now()
+		_, err := ParallelMap(ctx, items, 2, fn)
+		elapsed := time.Since(start)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("ParallelMap() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if elapsed >= 500*time.Millisecond {
+			t.Errorf("ParallelMap() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+}
+
+func TestForEach(t *testing.T) {
+	t.Run("visits every item", func(t *testing.T) {
+		var total atomic.Int64
+		items := []int{1, 2, 3, 4, 5}
+		err := ForEach(context.Background(), items, 2, func(ctx context.Context, n int) error {
+			total.Add(int64(n))
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ForEach() returned error: %v", err)
+		}
+		if total.Load() != 15 {
+			t.Errorf("ForEach() visited items totalling %d; want 15", total.Load())
+		}
+	})
+
+	t.Run("never exceeds concurrency", func(t *testing.T) {
+		const limit = 3
+		var active, peak atomic.Int32
+		items := make([]int, 30)
+
+		err := ForEach(context.Background(), items, limit, func(ctx context.Context, _ int) error {
+			n := active.Add(1)
+			defer active.Add(-1)
+			for {
+				p := peak.Load()
+				if n <= p || peak.CompareAndSwap(p, n) {
+					break
+				}
+			}
+			time.Sleep(2 * time.Millisecond)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("ForEach() returned error: %v", err)
+		}
+		if p := peak.Load(); p > limit {
+			t.Errorf("ForEach() ran %d calls at once; want at most %d", p, limit)
+		}
+		if p := peak.Load(); p < 2 {
+			t.Errorf("ForEach() ran at most %d call at once; want concurrent calls", p)
+		}
+	})
+
+	t.Run("errors propagate", func(t *testing.T) {
+		errOdd := errors.New("odd item")
+		errSeven := errors.New("seven")
+		var calls atomic.Int32
+		items := []int{1, 2, 3, 4, 5, 6, 7, 8}
+
+		err := ForEach(context.Background(), items, 4, func(ctx context.Context, n int) error {
+			calls.Add(1)
+			if n == 7 {
+				return errSeven
+			}
+			if n%2 == 1 {
+				return errOdd
+			}
+			return nil
+		})
+		if !errors.Is(err, errOdd) || !errors.Is(err, errSeven) {
+			t.Errorf("ForEach() error = %v; want both errOdd and errSeven", err)
+		}
+		if calls.Load() != int32(len(items)) {
+			t.Errorf("ForEach() called fn %d times; want %d", calls.Load(), len(items))
+		}
+	})
+
+	t.Run("stops dispatching on cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls atomic.Int32
+		items := make([]int, 100)
+
+		err := ForEach(ctx, items, 1, func(ctx context.Context, _ int) error {
+			if calls.Add(1) == 5 {
+				cancel()
+			}
+			return nil
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("ForEach() error = %v; want context.Canceled", err)
+		}
+		if n := calls.Load(); n != 5 {
+			t.Errorf("ForEach() called fn %d times; want 5", n)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		err := ForEach(context.Background(), []int{}, 2, func(ctx context.Context, _ int) error {
+			return errors.New("should not be called")
+		})
+		if err != nil {
+			t.Errorf("ForEach([]) returned error: %v", err)
+		}
+	})
+}
+
+func TestRace(t *testing.T) {
+	t.Run("one succeeds quickly", func(t *testing.T) {
+		var cancelled atomic.Int32
+		slow := func(ctx context.Context) error {
+			if err := Delay(ctx, time.Second); err != nil {
+				cancelled.Add(1)
+				return err
+			}
+			return nil
+		}
+		fast := func(ctx context.Context) error {
+			return Delay(ctx, 10*time.Millisecond)
+		}
+
+		start := time.Now()
+		if err := Race(context.Background(), slow, fast, slow); err != nil {
+			t.Fatalf("Race() returned error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed >= time.Second {
+			t.Errorf("Race() took %v; want it to return with the fast call", elapsed)
+		}
+
+		deadline := time.Now().Add(time.Second)
+		for cancelled.Load() < 2 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if n := cancelled.Load(); n != 2 {
+			t.Errorf("Race() cancelled %d slow calls; want 2", n)
+		}
+	})
+
+	t.Run("success after failures", func(t *testing.T) {
+		fail := func(ctx context.Context) error { return errFlaky }
+		succeed := func(ctx context.Context) error { return Delay(ctx, 10*time.Millisecond) }
+		if err := Race(context.Background(), fail, succeed, fail); err != nil {
+			t.Errorf("Race() returned error: %v", err)
+		}
+	})
+
+	t.Run("all fail", func(t *testing.T) {
+		errA, errB := errors.New("a failed"), errors.New("b failed")
+		err := Race(context.Background(),
+			func(ctx context.Context) error { return errA },
+			func(ctx context.Context) error { return errB },
+		)
+		if !errors.Is(err, errA) || !errors.Is(err, errB) {
+			t.Errorf("Race() error = %v; want both errors joined", err)
+		}
+	})
+
+	t.Run("external cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		wait := func(ctx context.Context) error {
+			<-ctx.Done()
+			time.Sleep(50 * time.Millisecond)
+			return ctx.Err()
+		}
+
+		start := time.Now()
+		err := Race(ctx, wait, wait)
+		if err != context.DeadlineExceeded {
+			t.Errorf("Race() error = %v; want %v", err, context.DeadlineExceeded)
+		}
+		if elapsed := time.Since(start); elapsed >= 60*time.Millisecond {
+			t.Errorf("Race() took %v; want it to return when ctx is cancelled", elapsed)
+		}
+	})
+
+	t.Run("no functions", func(t *testing.T) {
+		if err := Race(context.Background()); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Race() error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
+
+func TestMerge(t *testing.T) {
+	produce := func(values ...int) <-chan int {
+		ch := make(chan int)
+		go func() {
+			defer close(ch)
+			for _, v := range values {
+				ch <- v
+			}
+		}()
+		return ch
+	}
+
+	t.Run("all values arrive", func(t *testing.T) {
+		out := Merge(context.Background(), produce(1, 2, 3), produce(4, 5), produce(), produce(6))
+
+		var got []int
+		for v := range out {
+			got = append(got, v)
+		}
+		if expected := []int{1, 2, 3, 4, 5, 6}; !equalSlices(SortNumbers(got), expected) {
+			t.Errorf("Merge() delivered %v; want the values %v in any order", got, expected)
+		}
+	})
+
+	t.Run("order within each input is kept", func(t *testing.T) {
+		out := Merge(context.Background(), produce(1, 2, 3), produce(10, 20, 30))
+
+		var low, high []int
+		for v := range out {
+			if v < 10 {
+				low = append(low, v)
+			} else {
+				high = append(high, v)
+			}
+		}
+		if !equalSlices(low, []int{1, 2, 3}) || !equalSlices(high, []int{10, 20, 30}) {
+			t.Errorf("Merge() reordered an input: got %v and %v", low, high)
+		}
+	})
+
+	t.Run("no inputs", func(t *testing.T) {
+		select {
+		case _, ok := <-Merge[int](context.Background()):
+			if ok {
+				t.Error("Merge() with no inputs delivered a value")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Merge() with no inputs did not close its output")
+		}
+	})
+
+	t.Run("nil inputs are ignored", func(t *testing.T) {
+		var got []int
+		for v := range Merge(context.Background(), nil, produce(7), nil) {
+			got = append(got, v)
+		}
+		if !equalSlices(got, []int{7}) {
+			t.Errorf("Merge() delivered %v; want [7]", got)
+		}
+	})
+
+	t.Run("cancellation closes the output", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		never := make(chan int)
+		out := Merge(ctx, never, produce(1))
+
+		if v := <-out; v != 1 {
+			t.Fatalf("Merge() delivered %d; want 1", v)
+		}
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			if ok {
+				t.Error("Merge() delivered a value after cancellation")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("Merge() did not close its output after cancellation")
+		}
+	})
+}
@@ -0,0 +1,69 @@
+package mypackage
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ParseInt parses a base-10 integer with an optional leading sign.
+// Surrounding whitespace is ignored, and single underscores may separate
+// digits, as in "1_000_000". It returns an error wrapping ErrInvalidNumber
+// if s is not a valid integer, and one wrapping ErrOutOfRange if the value
+// does not fit in an int.
+func ParseInt(s string) (int, error) {
+	trimmed := strings.TrimSpace(s)
+	digits, ok := stripDigitSeparators(trimmed)
+	if !ok {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+	}
+	n, err := strconv.ParseInt(digits, 10, 0)
+	if err != nil {
+		return 0, parseError(err, s)
+	}
+	return int(n), nil
+}
+
+// ParseFloat parses a floating-point number in any form strconv.ParseFloat
+// accepts, ignoring surrounding whitespace. It returns an error wrapping
+// ErrInvalidNumber if s is not a valid number, and one wrapping
+// ErrOutOfRange if its magnitude is too large for a float64.
+func ParseFloat(s string) (float64, error) {
+	f, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+	if err != nil {
+		return 0, parseError(err, s)
+	}
+	return f, nil
+}
+
+// parseError translates a strconv error for input s into the package's
+// sentinel errors.
+func parseError(err error, s string) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return fmt.Errorf("%w: %q", ErrOutOfRange, s)
+	}
+	return fmt.Errorf("%w: %q", ErrInvalidNumber, s)
+}
+
+// stripDigitSeparators removes underscores from s, reporting false if any
+// underscore is not directly between two digits.
+func stripDigitSeparators(s string) (string, bool) {
+	if !strings.Contains(s, "_") {
+		return s, true
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] != '_' {
+			continue
+		}
+		if i == 0 || i == len(s)-1 || !isDigit(s[i-1]) || !isDigit(s[i+1]) {
+			return "", false
+		}
+	}
+	return strings.ReplaceAll(s, "_", ""), true
+}
+
+// isDigit reports whether c is an ASCII decimal digit.
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,88 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"strconv"
+	"testing"
+)
+
+func TestParseInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected int
+		err      error
+	}{
+		{"simple", "42", 42, nil},
+		{"negative", "-17", -17, nil},
+		{"explicit plus", "+8", 8, nil},
+		{"zero", "0", 0, nil},
+		{"surrounding whitespace", " \t42\n ", 42, nil},
+		{"underscore separators", "1_000_000", 1000000, nil},
+		{"negative with separators", "-12_345", -12345, nil},
+		{"max int", strconv.Itoa(math.MaxInt), math.MaxInt, nil},
+		{"empty", "", 0, ErrInvalidNumber},
+		{"only whitespace", "   ", 0, ErrInvalidNumber},
+		{"letters", "12ab", 0, ErrInvalidNumber},
+		{"decimal point", "1.5", 0, ErrInvalidNumber},
+		{"inner whitespace", "1 000", 0, ErrInvalidNumber},
+		{"leading underscore", "_100", 0, ErrInvalidNumber},
+		{"trailing underscore", "100_", 0, ErrInvalidNumber},
+		{"double underscore", "1__000", 0, ErrInvalidNumber},
+		{"underscore after sign", "-_100", 0, ErrInvalidNumber},
+		{"hex prefix", "0x1F", 0, ErrInvalidNumber},
+		{"too large", "99999999999999999999", 0, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseInt(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseInt(%q) error = %v; want %v", tt.input, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseInt(%q) = %d; want %d", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected float64
+		err      error
+	}{
+		{"integer", "3", 3, nil},
+		{"decimal", "2.5", 2.5, nil},
+		{"negative", "-0.125", -0.125, nil},
+		{"exponent", "1e3", 1000, nil},
+		{"surrounding whitespace", "  6.25\n", 6.25, nil},
+		{"infinity", "-Inf", math.Inf(-1), nil},
+		{"empty", "", 0, ErrInvalidNumber},
+		{"letters", "1.2.3", 0, ErrInvalidNumber},
+		{"trailing garbage", "4.5kg", 0, ErrInvalidNumber},
+		{"too large", "1e400", 0, ErrOutOfRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseFloat(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseFloat(%q) error = %v; want %v", tt.input, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseFloat(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("error includes input", func(t *testing.T) {
+		_, err := ParseFloat("abc")
+		if err == nil || err.Error() != `invalid number: "abc"` {
+			t.Errorf(`ParseFloat("abc") error = %v; want invalid number: "abc"`, err)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package mypackage
+
+import "math"
+
+// PowInt returns base raised to the power exp.
+// It uses exponentiation by squaring, so it needs O(log exp) multiplications.
+// It returns ErrNegative if exp is negative and ErrOverflow if the result
+// cannot be represented as an int.
+func PowInt(base, exp int) (int, error) {
+	if exp < 0 {
+		return 0, &ArithmeticError{Op: "PowInt", Err: ErrNegative}
+	}
+
+	result := 1
+	for exp > 0 {
+		var err error
+		if exp&1 == 1 {
+			if result, err = multiplyChecked(result, base); err != nil {
+				return 0, &ArithmeticError{Op: "PowInt", Err: err}
+			}
+		}
+		exp >>= 1
+		if exp > 0 {
+			if base, err = multiplyChecked(base, base); err != nil {
+				return 0, &ArithmeticError{Op: "PowInt", Err: err}
+			}
+		}
+	}
+	return result, nil
+}
+
+// PowFloat returns base raised to the power exp.
+// It is a thin wrapper around math.Pow and follows its special cases.
+func PowFloat(base, exp float64) float64 {
+	return math.Pow(base, exp)
+}
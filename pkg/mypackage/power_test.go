@@ -0,0 +1,66 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"testing"
+)
+
+func TestPowInt(t *testing.T) {
+	tests := []struct {
+		name      string
+		base, exp int
+		expected  int
+		err       error
+	}{
+		{"exponent zero", 7, 0, 1, nil},
+		{"zero to the zero", 0, 0, 1, nil},
+		{"exponent one", 7, 1, 7, nil},
+		{"small power", 3, 4, 81, nil},
+		{"negative base even exponent", -2, 4, 16, nil},
+		{"negative base odd exponent", -2, 3, -8, nil},
+		{"one to a large power", 1, math.MaxInt, 1, nil},
+		{"minus one to a large power", -1, math.MaxInt, -1, nil},
+		{"large power of two", 2, bits.UintSize - 2, 1 << (bits.UintSize - 2), nil},
+		{"min int", -2, bits.UintSize - 1, math.MinInt, nil},
+		{"overflow", 2, bits.UintSize - 1, 0, ErrOverflow},
+		{"overflow with large base", 10, 19, 0, ErrOverflow},
+		{"negative exponent", 2, -1, 0, ErrNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := PowInt(tt.base, tt.exp)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("PowInt(%d, %d) error = %v; want %v", tt.base, tt.exp, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("PowInt(%d, %d) = %d; want %d", tt.base, tt.exp, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestPowFloat(t *testing.T) {
+	tests := []struct {
+		name      string
+		base, exp float64
+		expected  float64
+	}{
+		{"exponent zero", 2.5, 0, 1},
+		{"exponent one", 2.5, 1, 2.5},
+		{"square", 1.5, 2, 2.25},
+		{"negative exponent", 2, -2, 0.25},
+		{"fractional exponent", 9, 0.5, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := PowFloat(tt.base, tt.exp)
+			if result != tt.expected {
+				t.Errorf("PowFloat(%f, %f) = %f; want %f", tt.base, tt.exp, result, tt.expected)
+			}
+		})
+	}
+}
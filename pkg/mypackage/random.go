@@ -0,0 +1,68 @@
+package mypackage
+
+import (
+	"math"
+	"math/rand"
+)
+
+// NewRandom returns a random number generator seeded with seed, so that
+// the same seed always produces the same sequence. The generator is not
+// safe for concurrent use.
+func NewRandom(seed int64) *rand.Rand {
+	return rand.New(rand.NewSource(seed))
+}
+
+// RandomInt returns a uniformly distributed integer in the inclusive range
+// [lo, hi] drawn from r, which must not be nil.
+// It returns ErrInvalidRange if lo > hi.
+func RandomInt(r *rand.Rand, lo, hi int) (int, error) {
+	if lo > hi {
+		return 0, ErrInvalidRange
+	}
+	// The span is computed in uint64 so that it cannot overflow even for
+	// the full int range.
+	span := uint64(hi) - uint64(lo)
+	return lo + int(randomUint64n(r, span)), nil
+}
+
+// randomUint64n returns a uniformly distributed value in [0, max].
+func randomUint64n(r *rand.Rand, max uint64) uint64 {
+	if max < math.MaxInt64 {
+		return uint64(r.Int63n(int64(max) + 1))
+	}
+	if max == math.MaxUint64 {
+		return r.Uint64()
+	}
+	for {
+		// Rejection sampling keeps the result unbiased; since max is at
+		// least 2^63-1, each draw is accepted with probability above 1/2.
+		if v := r.Uint64(); v <= max {
+			return v
+		}
+	}
+}
+
+// RandomFloat returns a uniformly distributed float64 in the half-open
+// range [lo, hi) drawn from r, which must not be nil. If lo == hi it
+// returns lo. It returns ErrInvalidRange if lo > hi.
+func RandomFloat(r *rand.Rand, lo, hi float64) (float64, error) {
+	if lo > hi {
+		return 0, ErrInvalidRange
+	}
+	v := lo + r.Float64()*(hi-lo)
+	if v >= hi && hi > lo {
+		// Rounding can land exactly on hi; keep the upper bound exclusive.
+		v = math.Nextafter(hi, lo)
+	}
+	return v, nil
+}
+
+// RandomChoice returns a uniformly chosen element of items drawn from r,
+// which must not be nil. It returns ErrEmptyInput if items is empty.
+func RandomChoice[T any](r *rand.Rand, items []T) (T, error) {
+	if len(items) == 0 {
+		var zero T
+		return zero, ErrEmptyInput
+	}
+	return items[r.Intn(len(items))], nil
+}
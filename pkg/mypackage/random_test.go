@@ -0,0 +1,160 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewRandom(t *testing.T) {
+	a, b := NewRandom(7), NewRandom(7)
+	for i := 0; i < 10; i++ {
+		if x, y := a.Int63(), b.Int63(); x != y {
+			t.Fatalf("NewRandom(7) sequences differ at %d: %d != %d", i, x, y)
+		}
+	}
+}
+
+func TestRandomInt(t *testing.T) {
+	t.Run("fixed seed sequence", func(t *testing.T) {
+		r := NewRandom(42)
+		expected := []int{2, 2, 1, 6, 6, 4, 4, 1}
+		for i, want := range expected {
+			result, err := RandomInt(r, 1, 6)
+			if err != nil {
+				t.Fatalf("RandomInt(r, 1, 6) returned error: %v", err)
+			}
+			if result != want {
+				t.Errorf("RandomInt(r, 1, 6) call %d = %d; want %d", i, result, want)
+			}
+		}
+	})
+
+	t.Run("stays within bounds", func(t *testing.T) {
+		r := NewRandom(1)
+		seen := make(map[int]bool)
+		for i := 0; i < 1000; i++ {
+			result, err := RandomInt(r, -3, 3)
+			if err != nil {
+				t.Fatalf("RandomInt(r, -3, 3) returned error: %v", err)
+			}
+			if result < -3 || result > 3 {
+				t.Fatalf("RandomInt(r, -3, 3) = %d; out of range", result)
+			}
+			seen[result] = true
+		}
+		if len(seen) != 7 {
+			t.Errorf("RandomInt(r, -3, 3) produced %d distinct values; want 7", len(seen))
+		}
+	})
+
+	t.Run("single value range", func(t *testing.T) {
+		result, err := RandomInt(NewRandom(1), 5, 5)
+		if err != nil || result != 5 {
+			t.Errorf("RandomInt(r, 5, 5) = %d, %v; want 5, nil", result, err)
+		}
+	})
+
+	t.Run("full int range", func(t *testing.T) {
+		r := NewRandom(1)
+		for i := 0; i < 100; i++ {
+			if _, err := RandomInt(r, math.MinInt, math.MaxInt); err != nil {
+				t.Fatalf("RandomInt(r, MinInt, MaxInt) returned error: %v", err)
+			}
+		}
+	})
+
+	t.Run("wide range", func(t *testing.T) {
+		r := NewRandom(1)
+		for i := 0; i < 100; i++ {
+			result, err := RandomInt(r, -1, math.MaxInt)
+			if err != nil || result < -1 {
+				t.Fatalf("RandomInt(r, -1, MaxInt) = %d, %v", result, err)
+			}
+		}
+	})
+
+	t.Run("min greater than max", func(t *testing.T) {
+		if _, err := RandomInt(NewRandom(1), 6, 1); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("RandomInt(r, 6, 1) error = %v; want %v", err, ErrInvalidRange)
+		}
+	})
+}
+
+func TestRandomFloat(t *testing.T) {
+	t.Run("seeded source is deterministic", func(t *testing.T) {
+		a, b := NewRandom(42), NewRandom(42)
+		for i := 0; i < 10; i++ {
+			x, err := RandomFloat(a, -1, 1)
+			if err != nil {
+				t.Fatalf("RandomFloat(r, -1, 1) returned error: %v", err)
+			}
+			y, _ := RandomFloat(b, -1, 1)
+			if x != y {
+				t.Fatalf("RandomFloat() with equal seeds differed at %d: %v != %v", i, x, y)
+			}
+		}
+	})
+
+	t.Run("stays within bounds", func(t *testing.T) {
+		r := NewRandom(3)
+		for i := 0; i < 1000; i++ {
+			result, err := RandomFloat(r, 2.5, 3.5)
+			if err != nil {
+				t.Fatalf("RandomFloat(r, 2.5, 3.5) returned error: %v", err)
+			}
+			if result < 2.5 || result >= 3.5 {
+				t.Fatalf("RandomFloat(r, 2.5, 3.5) = %v; out of range", result)
+			}
+		}
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		result, err := RandomFloat(NewRandom(1), 4, 4)
+		if err != nil || result != 4 {
+			t.Errorf("RandomFloat(r, 4, 4) = %v, %v; want 4, nil", result, err)
+		}
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		if _, err := RandomFloat(NewRandom(1), 1, 0); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("RandomFloat(r, 1, 0) error = %v; want %v", err, ErrInvalidRange)
+		}
+	})
+}
+
+func TestRandomChoice(t *testing.T) {
+	t.Run("seeded source is deterministic", func(t *testing.T) {
+		items := []string{"a", "b", "c", "d"}
+		a, b := NewRandom(9), NewRandom(9)
+		for i := 0; i < 10; i++ {
+			x, err := RandomChoice(a, items)
+			if err != nil {
+				t.Fatalf("RandomChoice() returned error: %v", err)
+			}
+			y, _ := RandomChoice(b, items)
+			if x != y {
+				t.Fatalf("RandomChoice() with equal seeds differed at %d: %q != %q", i, x, y)
+			}
+		}
+	})
+
+	t.Run("chooses every item eventually", func(t *testing.T) {
+		items := []int{10, 20, 30}
+		r := NewRandom(5)
+		seen := make(map[int]bool)
+		for i := 0; i < 100; i++ {
+			v, _ := RandomChoice(r, items)
+			seen[v] = true
+		}
+		if len(seen) != len(items) {
+			t.Errorf("RandomChoice() chose %d distinct items; want %d", len(seen), len(items))
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if _, err := RandomChoice(NewRandom(1), []int{}); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("RandomChoice(r, []) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
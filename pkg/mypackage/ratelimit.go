@@ -0,0 +1,88 @@
+package mypackage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// TokenBucket is a token-bucket rate limiter. Tokens are added
+// continuously at a fixed rate up to a maximum capacity, and each allowed
+// event consumes one token. It is safe for concurrent use.
+type TokenBucket struct {
+	mu       sync.Mutex
+	rate     float64
+	capacity float64
+	tokens   float64
+	last     time.Time
+}
+
+// NewTokenBucket returns a full TokenBucket that refills at rate tokens per
+// second and holds at most capacity tokens, which is the largest burst it
+// allows. A capacity less than one is treated as one. If rate is not
+// positive, the bucket never refills.
+func NewTokenBucket(rate float64, capacity int) *TokenBucket {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &TokenBucket{
+		rate:     rate,
+		capacity: float64(capacity),
+		tokens:   float64(capacity),
+		last:     time.Now(),
+	}
+}
+
+// Allow reports whether a token is available, consuming it if so.
+// It never blocks.
+func (b *TokenBucket) Allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.take()
+}
+
+// Wait blocks until a token is available and consumes it, or returns
+// ctx.Err() if the context is cancelled first. The wait uses Delay.
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		b.mu.Lock()
+		if b.take() {
+			b.mu.Unlock()
+			return nil
+		}
+		rate, missing := b.rate, 1-b.tokens
+		b.mu.Unlock()
+
+		if rate <= 0 {
+			<-ctx.Done()
+			return ctx.Err()
+		}
+		wait := time.Duration(missing / rate * float64(time.Second))
+		if err := Delay(ctx, wait); err != nil {
+			return err
+		}
+	}
+}
+
+// take refills the bucket and consumes a token if one is available.
+// The caller must hold b.mu.
+func (b *TokenBucket) take() bool {
+	now := time.Now()
+	if b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.capacity {
+			b.tokens = b.capacity
+		}
+	}
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
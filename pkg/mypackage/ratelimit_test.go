@@ -0,0 +1,113 @@
+package mypackage
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestTokenBucketAllow(t *testing.T) {
+	t.Run("burst capacity", func(t *testing.T) {
+		b := NewTokenBucket(1, 5)
+		for i := 0; i < 5; i++ {
+			if !b.Allow() {
+				t.Fatalf("Allow() = false on call %d; want true within burst", i+1)
+			}
+		}
+		if b.Allow() {
+			t.Error("Allow() = true after burst exhausted; want false")
+		}
+	})
+
+	t.Run("refills over time", func(t *testing.T) {
+		b := NewTokenBucket(100, 1)
+		if !b.Allow() {
+			t.Fatal("Allow() = false on a full bucket")
+		}
+		if b.Allow() {
+			t.Fatal("Allow() = true on an empty bucket")
+		}
+		time.Sleep(20 * time.Millisecond)
+		if !b.Allow() {
+			t.Error("Allow() = false after refill; want true")
+		}
+	})
+
+	t.Run("never exceeds capacity", func(t *testing.T) {
+		b := NewTokenBucket(1000, 2)
+		time.Sleep(20 * time.Millisecond)
+		allowed := 0
+		for i := 0; i < 10; i++ {
+			if b.Allow() {
+				allowed++
+			}
+		}
+		if allowed != 2 {
+			t.Errorf("Allow() admitted %d events; want capacity 2", allowed)
+		}
+	})
+
+	t.Run("non-positive rate never refills", func(t *testing.T) {
+		b := NewTokenBucket(0, 1)
+		b.Allow()
+		time.Sleep(5 * time.Millisecond)
+		if b.Allow() {
+			t.Error("Allow() = true for a bucket that never refills")
+		}
+	})
+}
+
+func TestTokenBucketWait(t *testing.T) {
+	t.Run("steady-state rate", func(t *testing.T) {
+		const rate = 100
+		b := NewTokenBucket(rate, 1)
+		ctx := context.Background()
+
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			if err := b.Wait(ctx); err != nil {
+				t.Fatalf("Wait() returned error: %v", err)
+			}
+		}
+		elapsed := time.Since(start)
+
+		// The first token is available immediately; five more take 50ms.
+		if elapsed < 45*time.Millisecond {
+			t.Errorf("Wait() admitted 6 events in %v; want at least 50ms", elapsed)
+		}
+		if elapsed >= 500*time.Millisecond {
+			t.Errorf("Wait() took too long: %v", elapsed)
+		}
+	})
+
+	t.Run("context cancellation", func(t *testing.T) {
+		b := NewTokenBucket(0.1, 1)
+		b.Allow()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		start := time.Now()
+		err := b.Wait(ctx)
+		elapsed := time.Since(start)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("Wait() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if elapsed >= time.Second {
+			t.Errorf("Wait() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+
+	t.Run("non-positive rate waits for cancellation", func(t *testing.T) {
+		b := NewTokenBucket(0, 1)
+		b.Allow()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		if err := b.Wait(ctx); err != context.DeadlineExceeded {
+			t.Errorf("Wait() should return context.DeadlineExceeded, got: %v", err)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package mypackage
+
+// Result holds either a value or the error that prevented producing one.
+// The zero Result is an Ok holding the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err. Err(nil) is an Ok holding the
+// zero value of T.
+func Err[T any](err error) Result[T] {
+	return Result[T]{err: err}
+}
+
+// IsOk reports whether r holds a value rather than an error.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// Unwrap returns the value and error held by r, in the usual Go form.
+func (r Result[T]) Unwrap() (T, error) {
+	return r.value, r.err
+}
+
+// UnwrapOr returns the value held by r, or fallback if r holds an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// AddResult is AddChecked returning a Result.
+func AddResult(a, b int) Result[int] {
+	sum, err := AddChecked(a, b)
+	if err != nil {
+		return Err[int](err)
+	}
+	return Ok(sum)
+}
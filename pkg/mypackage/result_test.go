@@ -0,0 +1,60 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	t.Run("ok", func(t *testing.T) {
+		r := Ok(42)
+		if !r.IsOk() {
+			t.Fatal("Ok(42).IsOk() = false; want true")
+		}
+		if v, err := r.Unwrap(); v != 42 || err != nil {
+			t.Errorf("Ok(42).Unwrap() = %d, %v; want 42, nil", v, err)
+		}
+		if v := r.UnwrapOr(-1); v != 42 {
+			t.Errorf("Ok(42).UnwrapOr(-1) = %d; want 42", v)
+		}
+	})
+
+	t.Run("err", func(t *testing.T) {
+		r := Err[string](ErrInvalidNumber)
+		if r.IsOk() {
+			t.Fatal("Err(ErrInvalidNumber).IsOk() = true; want false")
+		}
+		if v, err := r.Unwrap(); v != "" || !errors.Is(err, ErrInvalidNumber) {
+			t.Errorf("Err(ErrInvalidNumber).Unwrap() = %q, %v; want \"\", %v", v, err, ErrInvalidNumber)
+		}
+		if v := r.UnwrapOr("fallback"); v != "fallback" {
+			t.Errorf("Err(ErrInvalidNumber).UnwrapOr(\"fallback\") = %q; want \"fallback\"", v)
+		}
+	})
+
+	t.Run("zero value and Err(nil) are ok", func(t *testing.T) {
+		for _, r := range []Result[int]{{}, Err[int](nil)} {
+			if !r.IsOk() || r.UnwrapOr(5) != 0 {
+				t.Errorf("Result %+v: IsOk() = %v, UnwrapOr(5) = %d; want true, 0", r, r.IsOk(), r.UnwrapOr(5))
+			}
+		}
+	})
+}
+
+func TestAddResult(t *testing.T) {
+	if r := AddResult(2, 3); !r.IsOk() || r.UnwrapOr(0) != 5 {
+		t.Errorf("AddResult(2, 3) = %+v; want Ok(5)", r)
+	}
+
+	r := AddResult(math.MaxInt, 1)
+	if r.IsOk() {
+		t.Fatalf("AddResult(MaxInt, 1).IsOk() = true; want false")
+	}
+	if _, err := r.Unwrap(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("AddResult(MaxInt, 1) error = %v; want %v", err, ErrOverflow)
+	}
+	if v := r.UnwrapOr(-1); v != -1 {
+		t.Errorf("AddResult(MaxInt, 1).UnwrapOr(-1) = %d; want -1", v)
+	}
+}
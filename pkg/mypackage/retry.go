@@ -0,0 +1,153 @@
+package mypackage
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"time"
+)
+
+// Retry invokes fn up to attempts times, waiting delay between failures.
+// It returns nil as soon as fn succeeds, or the last error returned by fn
+// once all attempts are exhausted. If attempts is less than one, fn is
+// invoked once.
+// The wait uses Delay, so Retry returns ctx.Err() as soon as the context
+// is cancelled, without invoking fn again.
+func Retry(ctx context.Context, attempts int, delay time.Duration, fn func() error) error {
+	return retry(ctx, attempts, func(int) time.Duration { return delay }, fn)
+}
+
+// RetryWithBackoff behaves like Retry, but the delay starts at base and
+// doubles after each failed attempt, never exceeding maxDelay.
+func RetryWithBackoff(ctx context.Context, attempts int, base, maxDelay time.Duration, fn func() error) error {
+	return retry(ctx, attempts, func(attempt int) time.Duration {
+		return backoffDelay(base, maxDelay, attempt)
+	}, fn)
+}
+
+// RetryWithJitter behaves like RetryWithBackoff, but applies full jitter:
+// each delay is chosen uniformly at random from [0, backoff], where backoff
+// is the capped exponential delay. This spreads out retries from many
+// clients that failed at the same time.
+// The random delays are drawn from rng, which lets tests use a seeded source.
+// If rng is nil, the default source of the math/rand package is used.
+func RetryWithJitter(ctx context.Context, attempts int, base, maxDelay time.Duration, rng *rand.Rand, fn func() error) error {
+	return retry(ctx, attempts, func(attempt int) time.Duration {
+		return jitterDelay(rng, backoffDelay(base, maxDelay, attempt))
+	}, fn)
+}
+
+// BackoffStrategy decides how long RetryWithStrategy waits between attempts.
+// NextDelay is called with the zero-based index of the attempt that just
+// failed and returns the wait before the next one.
+type BackoffStrategy interface {
+	NextDelay(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same Interval after every failed attempt.
+type ConstantBackoff struct {
+	Interval time.Duration
+}
+
+// NextDelay returns b.Interval.
+func (b ConstantBackoff) NextDelay(attempt int) time.Duration {
+	return b.Interval
+}
+
+// LinearBackoff waits Initial after the first failed attempt and Step
+// longer after each one after that, never exceeding Max.
+// A Max of zero or less means the delay is not capped.
+type LinearBackoff struct {
+	Initial time.Duration
+	Step    time.Duration
+	Max     time.Duration
+}
+
+// NextDelay returns b.Initial + attempt*b.Step, capped at b.Max.
+func (b LinearBackoff) NextDelay(attempt int) time.Duration {
+	maxDelay := capOrUnlimited(b.Max)
+	if b.Step > 0 && time.Duration(attempt) > (maxDelay-b.Initial)/b.Step {
+		return maxDelay
+	}
+	return min(b.Initial+time.Duration(attempt)*b.Step, maxDelay)
+}
+
+// ExponentialBackoff waits Base after the first failed attempt and doubles
+// the delay after each one after that, never exceeding Max, as
+// RetryWithBackoff does. A Max of zero or less means the delay is not
+// capped.
+type ExponentialBackoff struct {
+	Base time.Duration
+	Max  time.Duration
+}
+
+// NextDelay returns b.Base doubled attempt times, capped at b.Max.
+func (b ExponentialBackoff) NextDelay(attempt int) time.Duration {
+	return backoffDelay(b.Base, capOrUnlimited(b.Max), attempt)
+}
+
+// RetryWithStrategy behaves like Retry, but asks strategy how long to wait
+// after each failed attempt.
+func RetryWithStrategy(ctx context.Context, attempts int, strategy BackoffStrategy, fn func() error) error {
+	return retry(ctx, attempts, strategy.NextDelay, fn)
+}
+
+// retry implements the retry loop shared by the Retry helpers.
+// nextDelay is called with the zero-based index of the failed attempt and
+// returns how long to wait before the next one.
+func retry(ctx context.Context, attempts int, nextDelay func(attempt int) time.Duration, fn func() error) error {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var err error
+	for i := 0; i < attempts; i++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		if err = fn(); err == nil {
+			return nil
+		}
+		if i < attempts-1 {
+			if ctxErr := Delay(ctx, nextDelay(i)); ctxErr != nil {
+				return ctxErr
+			}
+		}
+	}
+	return err
+}
+
+// backoffDelay returns base doubled attempt times, capped at maxDelay.
+func backoffDelay(base, maxDelay time.Duration, attempt int) time.Duration {
+	delay := base
+	for i := 0; i < attempt; i++ {
+		if delay > maxDelay/2 {
+			return maxDelay
+		}
+		delay *= 2
+	}
+	if delay > maxDelay {
+		return maxDelay
+	}
+	return delay
+}
+
+// jitterDelay returns a random duration in [0, d].
+func jitterDelay(rng *rand.Rand, d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	if rng == nil {
+		return time.Duration(rand.Int63n(int64(d) + 1))
+	}
+	return time.Duration(rng.Int63n(int64(d) + 1))
+}
+
+// capOrUnlimited returns maxDelay, or the longest representable duration if
+// maxDelay is not positive.
+func capOrUnlimited(maxDelay time.Duration) time.Duration {
+	if maxDelay <= 0 {
+		return math.MaxInt64
+	}
+	return maxDelay
+}
@@ -0,0 +1,378 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"testing"
+	"time"
+)
+
+var errFlaky = errors.New("flaky failure")
+
+// failTimes returns a function that fails n times before succeeding,
+// along with a pointer to its call counter.
+func failTimes(n int) (func() error, *int) {
+	calls := 0
+	return func() error {
+		calls++
+		if calls <= n {
+			return errFlaky
+		}
+		return nil
+	}, &calls
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("success on first try", func(t *testing.T) {
+		fn, calls := failTimes(0)
+		err := Retry(context.Background(), 3, time.Millisecond, fn)
+		if err != nil {
+			t.Errorf("Retry() returned error: %v", err)
+		}
+		if *calls != 1 {
+			t.Errorf("Retry() called fn %d times; want 1", *calls)
+		}
+	})
+
+	t.Run("success after failures", func(t *testing.T) {
+		fn, calls := failTimes(2)
+		err := Retry(context.Background(), 5, time.Millisecond, fn)
+		if err != nil {
+			t.Errorf("Retry() returned error: %v", err)
+		}
+		if *calls != 3 {
+			t.Errorf("Retry() called fn %d times; want 3", *calls)
+		}
+	})
+
+	t.Run("exhausts all attempts", func(t *testing.T) {
+		fn, calls := failTimes(10)
+		err := Retry(context.Background(), 4, time.Millisecond, fn)
+		if !errors.Is(err, errFlaky) {
+			t.Errorf("Retry() should return the last error, got: %v", err)
+		}
+		if *calls != 4 {
+			t.Errorf("Retry() called fn %d times; want 4", *calls)
+		}
+	})
+
+	t.Run("non-positive attempts invokes once", func(t *testing.T) {
+		fn, calls := failTimes(10)
+		err := Retry(context.Background(), 0, time.Millisecond, fn)
+		if !errors.Is(err, errFlaky) {
+			t.Errorf("Retry() should return fn's error, got: %v", err)
+		}
+		if *calls != 1 {
+			t.Errorf("Retry() called fn %d times; want 1", *calls)
+		}
+	})
+
+	t.Run("cancellation mid-retry", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		fn := func() error {
+			calls++
+			if calls == 2 {
+				cancel()
+			}
+			return errFlaky
+		}
+
+		start := time.Now()
+		err := Retry(ctx, 10, 50*time.Millisecond, fn)
+		elapsed := time.Since(start)
+
+		if err != context.Canceled {
+			t.Errorf("Retry() should return context.Canceled, got: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("Retry() called fn %d times; want 2", calls)
+		}
+		if elapsed >= 500*time.Millisecond {
+			t.Errorf("Retry() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+
+	t.Run("already cancelled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fn, calls := failTimes(0)
+		if err := Retry(ctx, 3, time.Millisecond, fn); err != context.Canceled {
+			t.Errorf("Retry() should return context.Canceled, got: %v", err)
+		}
+		if *calls != 0 {
+			t.Errorf("Retry() called fn %d times; want 0", *calls)
+		}
+	})
+}
+
+// recordCalls returns a function that always fails and records when it was
+// invoked.
+func recordCalls() (func() error, *[]time.Time) {
+	var calls []time.Time
+	return func() error {
+		calls = append(calls, time.Now())
+		return errFlaky
+	}, &calls
+}
+
+func TestRetryWithBackoff(t *testing.T) {
+	t.Run("delay grows geometrically", func(t *testing.T) {
+		fn, calls := recordCalls()
+		base := 10 * time.Millisecond
+
+		err := RetryWithBackoff(context.Background(), 4, base, time.Second, fn)
+		if !errors.Is(err, errFlaky) {
+			t.Fatalf("RetryWithBackoff() should return the last error, got: %v", err)
+		}
+		if len(*calls) != 4 {
+			t.Fatalf("RetryWithBackoff() called fn %d times; want 4", len(*calls))
+		}
+
+		expected := []time.Duration{base, 2 * base, 4 * base}
+		for i, want := range expected {
+			gap := (*calls)[i+1].Sub((*calls)[i])
+			if gap < want {
+				t.Errorf("gap before attempt %d = %v; want at least %v", i+2, gap, want)
+			}
+		}
+		if total := (*calls)[3].Sub((*calls)[0]); total < 7*base {
+			t.Errorf("total elapsed = %v; want at least %v", total, 7*base)
+		}
+	})
+
+	t.Run("maxDelay caps growth", func(t *testing.T) {
+		fn, calls := recordCalls()
+		base := 10 * time.Millisecond
+
+		start := time.Now()
+		_ = RetryWithBackoff(context.Background(), 5, base, base, fn)
+		elapsed := time.Since(start)
+
+		if len(*calls) != 5 {
+			t.Fatalf("RetryWithBackoff() called fn %d times; want 5", len(*calls))
+		}
+		if elapsed < 4*base {
+			t.Errorf("RetryWithBackoff() completed too quickly: %v", elapsed)
+		}
+		// Without the cap the delays would total 150ms.
+		if elapsed >= 15*base {
+			t.Errorf("RetryWithBackoff() did not cap delays, took: %v", elapsed)
+		}
+	})
+
+	t.Run("success stops retrying", func(t *testing.T) {
+		fn, calls := failTimes(1)
+		err := RetryWithBackoff(context.Background(), 5, time.Millisecond, 10*time.Millisecond, fn)
+		if err != nil {
+			t.Errorf("RetryWithBackoff() returned error: %v", err)
+		}
+		if *calls != 2 {
+			t.Errorf("RetryWithBackoff() called fn %d times; want 2", *calls)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+		fn, _ := recordCalls()
+
+		start := time.Now()
+		err := RetryWithBackoff(ctx, 10, 20*time.Millisecond, time.Second, fn)
+		elapsed := time.Since(start)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("RetryWithBackoff() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if elapsed >= time.Second {
+			t.Errorf("RetryWithBackoff() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempt  int
+		maxDelay time.Duration
+		expected time.Duration
+	}{
+		{"first attempt", 0, time.Second, 10 * time.Millisecond},
+		{"doubles", 1, time.Second, 20 * time.Millisecond},
+		{"doubles again", 3, time.Second, 80 * time.Millisecond},
+		{"capped", 3, 50 * time.Millisecond, 50 * time.Millisecond},
+		{"large attempt stays capped", 1000, time.Second, time.Second},
+		{"base above cap", 0, 5 * time.Millisecond, 5 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := backoffDelay(10*time.Millisecond, tt.maxDelay, tt.attempt)
+			if result != tt.expected {
+				t.Errorf("backoffDelay(10ms, %v, %d) = %v; want %v", tt.maxDelay, tt.attempt, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRetryWithJitter(t *testing.T) {
+	t.Run("delays fall within backoff bounds", func(t *testing.T) {
+		const seed = 42
+		base := 10 * time.Millisecond
+		maxDelay := 40 * time.Millisecond
+		fn, calls := recordCalls()
+
+		err := RetryWithJitter(context.Background(), 5, base, maxDelay, rand.New(rand.NewSource(seed)), fn)
+		if !errors.Is(err, errFlaky) {
+			t.Fatalf("RetryWithJitter() should return the last error, got: %v", err)
+		}
+		if len(*calls) != 5 {
+			t.Fatalf("RetryWithJitter() called fn %d times; want 5", len(*calls))
+		}
+
+		// Replaying the same seed yields the delays RetryWithJitter used.
+		replay := rand.New(rand.NewSource(seed))
+		for i := 0; i < 4; i++ {
+			backoff := backoffDelay(base, maxDelay, i)
+			want := jitterDelay(replay, backoff)
+			if want < 0 || want > backoff {
+				t.Errorf("jittered delay %d = %v; want within [0, %v]", i, want, backoff)
+			}
+			if gap := (*calls)[i+1].Sub((*calls)[i]); gap < want {
+				t.Errorf("gap before attempt %d = %v; want at least %v", i+2, gap, want)
+			}
+		}
+	})
+
+	t.Run("seeded source is deterministic", func(t *testing.T) {
+		a := rand.New(rand.NewSource(7))
+		b := rand.New(rand.NewSource(7))
+		for i := 0; i < 10; i++ {
+			d := backoffDelay(time.Millisecond, time.Second, i)
+			if x, y := jitterDelay(a, d), jitterDelay(b, d); x != y {
+				t.Fatalf("jitterDelay() with equal seeds differed at %d: %v != %v", i, x, y)
+			}
+		}
+	})
+
+	t.Run("zero backoff has no delay", func(t *testing.T) {
+		if d := jitterDelay(rand.New(rand.NewSource(1)), 0); d != 0 {
+			t.Errorf("jitterDelay(0) = %v; want 0", d)
+		}
+	})
+
+	t.Run("nil rng uses default source", func(t *testing.T) {
+		fn, calls := failTimes(1)
+		err := RetryWithJitter(context.Background(), 3, time.Millisecond, time.Millisecond, nil, fn)
+		if err != nil {
+			t.Errorf("RetryWithJitter() returned error: %v", err)
+		}
+		if *calls != 2 {
+			t.Errorf("RetryWithJitter() called fn %d times; want 2", *calls)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		fn := func() error {
+			calls++
+			cancel()
+			return errFlaky
+		}
+
+		err := RetryWithJitter(ctx, 10, time.Second, time.Second, rand.New(rand.NewSource(1)), fn)
+		if err != context.Canceled {
+			t.Errorf("RetryWithJitter() should return context.Canceled, got: %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("RetryWithJitter() called fn %d times; want 1", calls)
+		}
+	})
+}
+
+func TestBackoffStrategies(t *testing.T) {
+	ms := time.Millisecond
+
+	tests := []struct {
+		name     string
+		strategy BackoffStrategy
+		expected []time.Duration
+	}{
+		{"constant", ConstantBackoff{Interval: 15 * ms}, []time.Duration{15 * ms, 15 * ms, 15 * ms, 15 * ms}},
+		{"linear", LinearBackoff{Initial: 10 * ms, Step: 5 * ms}, []time.Duration{10 * ms, 15 * ms, 20 * ms, 25 * ms}},
+		{"linear capped", LinearBackoff{Initial: 10 * ms, Step: 10 * ms, Max: 25 * ms}, []time.Duration{10 * ms, 20 * ms, 25 * ms, 25 * ms}},
+		{"exponential", ExponentialBackoff{Base: 10 * ms}, []time.Duration{10 * ms, 20 * ms, 40 * ms, 80 * ms}},
+		{"exponential capped", ExponentialBackoff{Base: 10 * ms, Max: 30 * ms}, []time.Duration{10 * ms, 20 * ms, 30 * ms, 30 * ms}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for attempt, want := range tt.expected {
+				if got := tt.strategy.NextDelay(attempt); got != want {
+					t.Errorf("%T.NextDelay(%d) = %v; want %v", tt.strategy, attempt, got, want)
+				}
+			}
+		})
+	}
+
+	t.Run("uncapped growth saturates instead of overflowing", func(t *testing.T) {
+		var longest time.Duration = math.MaxInt64
+		if got := (LinearBackoff{Initial: time.Second, Step: time.Hour}).NextDelay(math.MaxInt); got != longest {
+			t.Errorf("LinearBackoff.NextDelay(MaxInt) = %v; want %v", got, longest)
+		}
+		if got := (ExponentialBackoff{Base: time.Second}).NextDelay(1000); got != longest {
+			t.Errorf("ExponentialBackoff.NextDelay(1000) = %v; want %v", got, longest)
+		}
+	})
+}
+
+func TestRetryWithStrategy(t *testing.T) {
+	t.Run("waits as the strategy says", func(t *testing.T) {
+		fn, calls := recordCalls()
+		step := 10 * time.Millisecond
+
+		err := RetryWithStrategy(context.Background(), 4, LinearBackoff{Initial: step, Step: step}, fn)
+		if !errors.Is(err, errFlaky) {
+			t.Fatalf("RetryWithStrategy() should return the last error, got: %v", err)
+		}
+		if len(*calls) != 4 {
+			t.Fatalf("RetryWithStrategy() called fn %d times; want 4", len(*calls))
+		}
+		for i, want := range []time.Duration{step, 2 * step, 3 * step} {
+			if gap := (*calls)[i+1].Sub((*calls)[i]); gap < want {
+				t.Errorf("gap before attempt %d = %v; want at least %v", i+2, gap, want)
+			}
+		}
+	})
+
+	t.Run("success stops retrying", func(t *testing.T) {
+		fn, calls := failTimes(2)
+		err := RetryWithStrategy(context.Background(), 5, ConstantBackoff{Interval: time.Millisecond}, fn)
+		if err != nil {
+			t.Errorf("RetryWithStrategy() returned error: %v", err)
+		}
+		if *calls != 3 {
+			t.Errorf("RetryWithStrategy() called fn %d times; want 3", *calls)
+		}
+	})
+
+	t.Run("respects context cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+		fn, _ := recordCalls()
+
+		start := time.Now()
+		err := RetryWithStrategy(ctx, 10, ExponentialBackoff{Base: 20 * time.Millisecond}, fn)
+		elapsed := time.Since(start)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("RetryWithStrategy() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if elapsed >= time.Second {
+			t.Errorf("RetryWithStrategy() should have been cancelled early, took: %v", elapsed)
+		}
+	})
+}
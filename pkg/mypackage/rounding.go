@@ -0,0 +1,36 @@
+package mypackage
+
+import "math"
+
+// RoundTo rounds value to the given number of decimal places, rounding
+// half away from zero. A negative places rounds to the left of the decimal
+// point, so RoundTo(1250, -2) is 1300.
+//
+// Rounding operates on the binary float64 representation, which cannot
+// hold most decimal fractions exactly. For example 1.005 is stored as
+// 1.00499999..., so RoundTo(1.005, 2) returns 1 rather than 1.01.
+// Callers that need exact decimal rounding should use integer cents or
+// math/big instead. NaN, infinities, and values too large to scale are
+// returned unchanged, while a places so negative that 10^-places overflows
+// rounds every finite value to zero, keeping its sign.
+func RoundTo(value float64, places int) float64 {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return value
+	}
+	if places < 0 {
+		scale := math.Pow(10, float64(-places))
+		if math.IsInf(scale, 0) {
+			return math.Copysign(0, value)
+		}
+		return math.Round(value/scale) * scale
+	}
+	scale := math.Pow(10, float64(places))
+	if math.IsInf(scale, 0) {
+		return value
+	}
+	scaled := value * scale
+	if math.IsInf(scaled, 0) {
+		return value
+	}
+	return math.Round(scaled) / scale
+}
@@ -0,0 +1,56 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRoundTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		places   int
+		expected float64
+	}{
+		{"two places", 3.14159, 2, 3.14},
+		{"rounds half away from zero", 0.125, 2, 0.13},
+		{"negative half away from zero", -0.125, 2, -0.13},
+		{"half at two places", 2.675, 2, 2.68},
+		{"binary representation caveat", 1.005, 2, 1},
+		{"zero places", 2.5, 0, 3},
+		{"negative value zero places", -2.5, 0, -3},
+		{"negative value", -1.23456, 3, -1.235},
+		{"tens", 1234.5, -1, 1230},
+		{"hundreds", 1250, -2, 1300},
+		{"negative hundreds", -1250, -2, -1300},
+		{"already rounded", 1.5, 3, 1.5},
+		{"huge places", 1.5, 400, 1.5},
+		{"zero with huge places", 0, 400, 0},
+		{"huge negative places", 5, -400, 0},
+		{"large value", 1e300, 20, 1e300},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := RoundTo(tt.value, tt.places)
+			if result != tt.expected {
+				t.Errorf("RoundTo(%v, %d) = %v; want %v", tt.value, tt.places, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("special values", func(t *testing.T) {
+		if result := RoundTo(math.NaN(), 2); !math.IsNaN(result) {
+			t.Errorf("RoundTo(NaN, 2) = %v; want NaN", result)
+		}
+		if result := RoundTo(math.Inf(-1), 2); !math.IsInf(result, -1) {
+			t.Errorf("RoundTo(-Inf, 2) = %v; want -Inf", result)
+		}
+		if result := RoundTo(-5, -400); result != 0 || !math.Signbit(result) {
+			t.Errorf("RoundTo(-5, -400) = %v; want -0", result)
+		}
+		if result := RoundTo(math.Copysign(0, -1), 400); result != 0 || !math.Signbit(result) {
+			t.Errorf("RoundTo(-0, 400) = %v; want -0", result)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package mypackage
+
+import "math"
+
+// RunningMean maintains the mean of a stream of values without storing
+// them, using Welford's numerically stable update. The zero value is an
+// empty RunningMean ready to use. It is not safe for concurrent use.
+type RunningMean struct {
+	count int
+	mean  float64
+}
+
+// Add incorporates value into the running mean.
+func (r *RunningMean) Add(value float64) {
+	r.count++
+	r.mean += (value - r.mean) / float64(r.count)
+}
+
+// Mean returns the mean of the values added so far, or 0 if none have
+// been added.
+func (r *RunningMean) Mean() float64 {
+	return r.mean
+}
+
+// Count returns the number of values added so far.
+func (r *RunningMean) Count() int {
+	return r.count
+}
+
+// RunningStats maintains the mean and variance of a stream of values in a
+// single pass, using Welford's online algorithm. The zero value is an empty
+// RunningStats ready to use. It is not safe for concurrent use.
+type RunningStats struct {
+	mean RunningMean
+	m2   float64
+}
+
+// Add incorporates value into the running statistics.
+func (r *RunningStats) Add(value float64) {
+	delta := value - r.mean.Mean()
+	r.mean.Add(value)
+	r.m2 += delta * (value - r.mean.Mean())
+}
+
+// Mean returns the mean of the values added so far, or 0 if none have
+// been added.
+func (r *RunningStats) Mean() float64 {
+	return r.mean.Mean()
+}
+
+// Count returns the number of values added so far.
+func (r *RunningStats) Count() int {
+	return r.mean.Count()
+}
+
+// Variance returns the variance of the values added so far, with the same
+// sample flag and errors as the batch Variance function.
+func (r *RunningStats) Variance(sample bool) (float64, error) {
+	n := r.Count()
+	if n == 0 {
+		return 0, ErrEmptyInput
+	}
+	if sample {
+		if n < 2 {
+			return 0, ErrInsufficientData
+		}
+		n--
+	}
+	return r.m2 / float64(n), nil
+}
+
+// StdDev returns the standard deviation of the values added so far, the
+// square root of Variance.
+func (r *RunningStats) StdDev(sample bool) (float64, error) {
+	variance, err := r.Variance(sample)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
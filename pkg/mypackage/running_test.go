@@ -0,0 +1,114 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRunningMean(t *testing.T) {
+	t.Run("empty state", func(t *testing.T) {
+		var r RunningMean
+		if r.Count() != 0 {
+			t.Errorf("Count() = %d; want 0", r.Count())
+		}
+		if r.Mean() != 0 {
+			t.Errorf("Mean() = %f; want 0", r.Mean())
+		}
+	})
+
+	t.Run("matches batch Mean", func(t *testing.T) {
+		values := []float64{2, 4, 4, 4, 5, 5, 7, 9, -3.5, 12.25}
+		var r RunningMean
+		for i, v := range values {
+			r.Add(v)
+
+			want, err := Mean(values[:i+1])
+			if err != nil {
+				t.Fatalf("Mean() returned error: %v", err)
+			}
+			if math.Abs(r.Mean()-want) > 1e-12 {
+				t.Errorf("after %d values Mean() = %f; want %f", i+1, r.Mean(), want)
+			}
+		}
+		if r.Count() != len(values) {
+			t.Errorf("Count() = %d; want %d", r.Count(), len(values))
+		}
+	})
+
+	t.Run("stable with a large offset", func(t *testing.T) {
+		var r RunningMean
+		for i := 0; i < 1000; i++ {
+			r.Add(1e9 + float64(i%2))
+		}
+		if want := 1e9 + 0.5; math.Abs(r.Mean()-want) > 1e-6 {
+			t.Errorf("Mean() = %f; want %f", r.Mean(), want)
+		}
+	})
+}
+
+func TestRunningStats(t *testing.T) {
+	t.Run("matches batch Variance and StdDev", func(t *testing.T) {
+		values := []float64{2, 4, 4, 4, 5, 5, 7, 9, -3.5, 12.25}
+		var r RunningStats
+		for _, v := range values {
+			r.Add(v)
+		}
+
+		if r.Count() != len(values) {
+			t.Errorf("Count() = %d; want %d", r.Count(), len(values))
+		}
+		if mean, _ := Mean(values); math.Abs(r.Mean()-mean) > 1e-12 {
+			t.Errorf("Mean() = %f; want %f", r.Mean(), mean)
+		}
+
+		for _, sample := range []bool{false, true} {
+			wantVar, _ := Variance(values, sample)
+			gotVar, err := r.Variance(sample)
+			if err != nil || math.Abs(gotVar-wantVar) > 1e-9 {
+				t.Errorf("Variance(%v) = %f, %v; want %f, nil", sample, gotVar, err, wantVar)
+			}
+
+			wantStd, _ := StdDev(values, sample)
+			gotStd, err := r.StdDev(sample)
+			if err != nil || math.Abs(gotStd-wantStd) > 1e-9 {
+				t.Errorf("StdDev(%v) = %f, %v; want %f, nil", sample, gotStd, err, wantStd)
+			}
+		}
+	})
+
+	t.Run("single element", func(t *testing.T) {
+		var r RunningStats
+		r.Add(42)
+
+		if v, err := r.Variance(false); err != nil || v != 0 {
+			t.Errorf("Variance(false) = %f, %v; want 0, nil", v, err)
+		}
+		if _, err := r.Variance(true); !errors.Is(err, ErrInsufficientData) {
+			t.Errorf("Variance(true) error = %v; want %v", err, ErrInsufficientData)
+		}
+		if _, err := r.StdDev(true); !errors.Is(err, ErrInsufficientData) {
+			t.Errorf("StdDev(true) error = %v; want %v", err, ErrInsufficientData)
+		}
+	})
+
+	t.Run("empty state", func(t *testing.T) {
+		var r RunningStats
+		if r.Mean() != 0 {
+			t.Errorf("Mean() = %f; want 0", r.Mean())
+		}
+		if _, err := r.Variance(false); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Variance(false) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+
+	t.Run("stable with a large offset", func(t *testing.T) {
+		var r RunningStats
+		for i := 0; i < 1000; i++ {
+			r.Add(1e9 + float64(i%2))
+		}
+		if v, _ := r.Variance(false); math.Abs(v-0.25) > 1e-6 {
+			t.Errorf("Variance(false) = %f; want 0.25", v)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package mypackage
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Every calls fn once per interval d until ctx is cancelled or fn returns
+// an error. The first call happens after d has elapsed, not immediately.
+// Calls never overlap: if fn takes longer than d, ticks that would have
+// fired meanwhile are dropped.
+// Every returns fn's error, or ctx.Err() once the context is cancelled.
+// It returns ErrInvalidDuration if d is not positive.
+func Every(ctx context.Context, d time.Duration, fn func(context.Context) error) error {
+	if d <= 0 {
+		return ErrInvalidDuration
+	}
+
+	ticker := time.NewTicker(d)
+	defer func() {
+		ticker.Stop()
+		select {
+		case <-ticker.C:
+		default:
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := fn(ctx); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Scheduler runs deferred callbacks, each on its own goroutine after its
+// delay has elapsed. The zero value is ready to use, and all methods are
+// safe for concurrent use.
+type Scheduler struct {
+	mu      sync.Mutex
+	nextID  uint64
+	pending map[uint64]context.CancelFunc
+}
+
+// Schedule registers fn to run once after d. fn is dropped without being
+// called if ctx is cancelled or Stop is called before d elapses.
+// A non-positive d runs fn as soon as possible.
+func (s *Scheduler) Schedule(ctx context.Context, d time.Duration, fn func()) {
+	taskCtx, cancel := context.WithCancel(ctx)
+
+	s.mu.Lock()
+	if s.pending == nil {
+		s.pending = make(map[uint64]context.CancelFunc)
+	}
+	id := s.nextID
+	s.nextID++
+	s.pending[id] = cancel
+	s.mu.Unlock()
+
+	go func() {
+		err := Delay(taskCtx, d)
+
+		s.mu.Lock()
+		// Delay can report success even when taskCtx was cancelled at the
+		// same moment, so check again while holding the lock Stop needs.
+		if err == nil {
+			err = taskCtx.Err()
+		}
+		delete(s.pending, id)
+		s.mu.Unlock()
+		cancel()
+
+		if err == nil {
+			fn()
+		}
+	}()
+}
+
+// Stop cancels every callback that has not started yet. Callbacks already
+// running are not interrupted. The Scheduler can be reused after Stop.
+func (s *Scheduler) Stop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for id, cancel := range s.pending {
+		cancel()
+		delete(s.pending, id)
+	}
+}
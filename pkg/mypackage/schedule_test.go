@@ -0,0 +1,163 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestEvery(t *testing.T) {
+	t.Run("invokes fn periodically until cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 110*time.Millisecond)
+		defer cancel()
+
+		calls := 0
+		err := Every(ctx, 20*time.Millisecond, func(ctx context.Context) error {
+			calls++
+			return nil
+		})
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("Every() should return context.DeadlineExceeded, got: %v", err)
+		}
+		// Roughly five ticks fit in the window; allow for scheduling jitter.
+		if calls < 3 || calls > 6 {
+			t.Errorf("Every() invoked fn %d times; want about 5", calls)
+		}
+	})
+
+	t.Run("first call happens after d", func(t *testing.T) {
+		start := time.Now()
+		var firstAt time.Time
+		errStop := errors.New("stop")
+
+		_ = Every(context.Background(), 30*time.Millisecond, func(ctx context.Context) error {
+			firstAt = time.Now()
+			return errStop
+		})
+
+		if elapsed := firstAt.Sub(start); elapsed < 30*time.Millisecond {
+			t.Errorf("Every() first call after %v; want at least 30ms", elapsed)
+		}
+	})
+
+	t.Run("error stops the loop", func(t *testing.T) {
+		errStop := errors.New("stop")
+		calls := 0
+		err := Every(context.Background(), 5*time.Millisecond, func(ctx context.Context) error {
+			calls++
+			if calls == 3 {
+				return errStop
+			}
+			return nil
+		})
+
+		if !errors.Is(err, errStop) {
+			t.Errorf("Every() should return fn's error, got: %v", err)
+		}
+		if calls != 3 {
+			t.Errorf("Every() invoked fn %d times; want 3", calls)
+		}
+	})
+
+	t.Run("invalid duration", func(t *testing.T) {
+		err := Every(context.Background(), 0, func(ctx context.Context) error { return nil })
+		if !errors.Is(err, ErrInvalidDuration) {
+			t.Errorf("Every(0) error = %v; want %v", err, ErrInvalidDuration)
+		}
+	})
+}
+
+func TestScheduler(t *testing.T) {
+	t.Run("callbacks fire at their times", func(t *testing.T) {
+		var s Scheduler
+		start := time.Now()
+		fired := make(chan int, 3)
+		var mu sync.Mutex
+		firedAt := make(map[int]time.Duration)
+
+		for _, n := range []int{3, 1, 2} {
+			n := n
+			s.Schedule(context.Background(), time.Duration(n)*20*time.Millisecond, func() {
+				mu.Lock()
+				firedAt[n] = time.Since(start)
+				mu.Unlock()
+				fired <- n
+			})
+		}
+
+		var order []int
+		for i := 0; i < 3; i++ {
+			select {
+			case n := <-fired:
+				order = append(order, n)
+			case <-time.After(time.Second):
+				t.Fatalf("Scheduler fired %v before timing out; want 3 callbacks", order)
+			}
+		}
+
+		if expected := []int{1, 2, 3}; !equalSlices(order, expected) {
+			t.Errorf("Scheduler fired callbacks in order %v; want %v", order, expected)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		for n, at := range firedAt {
+			if want := time.Duration(n) * 20 * time.Millisecond; at < want {
+				t.Errorf("callback %d fired after %v; want at least %v", n, at, want)
+			}
+		}
+	})
+
+	t.Run("Stop cancels callbacks not yet fired", func(t *testing.T) {
+		var s Scheduler
+		var calls atomic.Int32
+		early := make(chan struct{})
+
+		s.Schedule(context.Background(), 0, func() {
+			calls.Add(1)
+			close(early)
+		})
+		for i := 0; i < 3; i++ {
+			s.Schedule(context.Background(), 50*time.Millisecond, func() { calls.Add(1) })
+		}
+
+		<-early
+		s.Stop()
+		time.Sleep(100 * time.Millisecond)
+
+		if got := calls.Load(); got != 1 {
+			t.Errorf("Scheduler ran %d callbacks after Stop; want 1", got)
+		}
+	})
+
+	t.Run("context cancellation drops the callback", func(t *testing.T) {
+		var s Scheduler
+		ctx, cancel := context.WithCancel(context.Background())
+		var calls atomic.Int32
+
+		s.Schedule(ctx, 30*time.Millisecond, func() { calls.Add(1) })
+		s.Schedule(context.Background(), 30*time.Millisecond, func() { calls.Add(10) })
+		cancel()
+		time.Sleep(80 * time.Millisecond)
+
+		if got := calls.Load(); got != 10 {
+			t.Errorf("Scheduler callbacks added %d; want only the uncancelled one (10)", got)
+		}
+	})
+
+	t.Run("reusable after Stop", func(t *testing.T) {
+		var s Scheduler
+		s.Stop()
+		done := make(chan struct{})
+		s.Schedule(context.Background(), time.Millisecond, func() { close(done) })
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("Scheduler did not run a callback scheduled after Stop")
+		}
+	})
+}
@@ -0,0 +1,182 @@
+package mypackage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a semantic version as described at https://semver.org.
+type Semver struct {
+	Major, Minor, Patch uint64
+	// Prerelease holds the dot-separated pre-release identifiers,
+	// such as "alpha.1", or is empty for a normal release.
+	Prerelease string
+	// Build holds the dot-separated build metadata, such as "exp.sha.5114f85".
+	// It is ignored when comparing versions.
+	Build string
+}
+
+// ParseSemver parses a version of the form "major.minor.patch" with
+// optional "-prerelease" and "+build" suffixes.
+// It returns an error wrapping ErrInvalidVersion if s is malformed.
+func ParseSemver(s string) (Semver, error) {
+	var v Semver
+	rest := s
+
+	if i := strings.IndexByte(rest, '+'); i >= 0 {
+		v.Build = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Build, false) {
+			return Semver{}, fmt.Errorf("%w %q: malformed build metadata", ErrInvalidVersion, s)
+		}
+	}
+	if i := strings.IndexByte(rest, '-'); i >= 0 {
+		v.Prerelease = rest[i+1:]
+		rest = rest[:i]
+		if !validIdentifiers(v.Prerelease, true) {
+			return Semver{}, fmt.Errorf("%w %q: malformed pre-release", ErrInvalidVersion, s)
+		}
+	}
+
+	parts := strings.Split(rest, ".")
+	if len(parts) != 3 {
+		return Semver{}, fmt.Errorf("%w %q: want major.minor.patch", ErrInvalidVersion, s)
+	}
+	fields := []*uint64{&v.Major, &v.Minor, &v.Patch}
+	for i, part := range parts {
+		if !isNumericIdentifier(part) {
+			return Semver{}, fmt.Errorf("%w %q: malformed number %q", ErrInvalidVersion, s, part)
+		}
+		n, err := strconv.ParseUint(part, 10, 64)
+		if err != nil {
+			return Semver{}, fmt.Errorf("%w %q: %v", ErrInvalidVersion, s, err)
+		}
+		*fields[i] = n
+	}
+	return v, nil
+}
+
+// String returns the version in its canonical textual form.
+func (v Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if v.Prerelease != "" {
+		s += "-" + v.Prerelease
+	}
+	if v.Build != "" {
+		s += "+" + v.Build
+	}
+	return s
+}
+
+// Compare returns -1, 0, or +1 depending on whether v has lower, equal,
+// or higher precedence than other. Build metadata is ignored, and a
+// pre-release version has lower precedence than the normal version,
+// so 1.0.0-alpha < 1.0.0.
+func (v Semver) Compare(other Semver) int {
+	if c := compareUint(v.Major, other.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Minor, other.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Patch, other.Patch); c != 0 {
+		return c
+	}
+	return comparePrerelease(v.Prerelease, other.Prerelease)
+}
+
+// Less reports whether v has lower precedence than other.
+func (v Semver) Less(other Semver) bool {
+	return v.Compare(other) < 0
+}
+
+// ParsedVersion returns the package's Version parsed as a Semver.
+func ParsedVersion() (Semver, error) {
+	return ParseSemver(Version)
+}
+
+// comparePrerelease compares two pre-release strings by semver precedence.
+func comparePrerelease(a, b string) int {
+	switch {
+	case a == b:
+		return 0
+	case a == "":
+		return 1
+	case b == "":
+		return -1
+	}
+
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		if c := compareIdentifier(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(as)), uint64(len(bs)))
+}
+
+// compareIdentifier compares two pre-release identifiers. Numeric
+// identifiers compare numerically and sort before alphanumeric ones.
+func compareIdentifier(a, b string) int {
+	aNum, bNum := isNumericIdentifier(a), isNumericIdentifier(b)
+	switch {
+	case aNum && bNum:
+		if c := compareUint(uint64(len(a)), uint64(len(b))); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	case aNum:
+		return -1
+	case bNum:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// validIdentifiers reports whether s is a non-empty, dot-separated list of
+// identifiers made of ASCII alphanumerics and hyphens. If strictNumeric is
+// set, numeric identifiers must not have leading zeros.
+func validIdentifiers(s string, strictNumeric bool) bool {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return false
+		}
+		for _, r := range id {
+			if !(r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r == '-') {
+				return false
+			}
+		}
+		if strictNumeric && isDigits(id) && !isNumericIdentifier(id) {
+			return false
+		}
+	}
+	return true
+}
+
+// isNumericIdentifier reports whether s is a number without leading zeros.
+func isNumericIdentifier(s string) bool {
+	return isDigits(s) && (s == "0" || s[0] != '0')
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,126 @@
+package mypackage
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseSemver(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected Semver
+	}{
+		{"0.1.0", Semver{Major: 0, Minor: 1, Patch: 0}},
+		{"1.2.3", Semver{Major: 1, Minor: 2, Patch: 3}},
+		{"10.20.30", Semver{Major: 10, Minor: 20, Patch: 30}},
+		{"1.0.0-alpha", Semver{Major: 1, Prerelease: "alpha"}},
+		{"1.0.0-alpha.1", Semver{Major: 1, Prerelease: "alpha.1"}},
+		{"1.0.0-0.3.7", Semver{Major: 1, Prerelease: "0.3.7"}},
+		{"1.0.0-x-y-z.--", Semver{Major: 1, Prerelease: "x-y-z.--"}},
+		{"1.0.0+20130313144700", Semver{Major: 1, Build: "20130313144700"}},
+		{"1.0.0-beta+exp.sha.5114f85", Semver{Major: 1, Prerelease: "beta", Build: "exp.sha.5114f85"}},
+		{"1.0.0+001", Semver{Major: 1, Build: "001"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseSemver(tt.input)
+			if err != nil {
+				t.Fatalf("ParseSemver(%q) returned error: %v", tt.input, err)
+			}
+			if result != tt.expected {
+				t.Errorf("ParseSemver(%q) = %+v; want %+v", tt.input, result, tt.expected)
+			}
+			if result.String() != tt.input {
+				t.Errorf("ParseSemver(%q).String() = %q; want %q", tt.input, result.String(), tt.input)
+			}
+		})
+	}
+}
+
+func TestParseSemverMalformed(t *testing.T) {
+	inputs := []string{
+		"",
+		"1",
+		"1.2",
+		"1.2.3.4",
+		"v1.2.3",
+		"01.2.3",
+		"1.02.3",
+		"1.2.-3",
+		"1.2.x",
+		"1.2.3-",
+		"1.2.3-alpha..1",
+		"1.2.3-01",
+		"1.2.3-alpha_1",
+		"1.2.3+",
+		"1.2.3+build..1",
+		"1.2.3 ",
+		"99999999999999999999.0.0",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			if _, err := ParseSemver(input); !errors.Is(err, ErrInvalidVersion) {
+				t.Errorf("ParseSemver(%q) error = %v; want %v", input, err, ErrInvalidVersion)
+			}
+		})
+	}
+}
+
+func TestSemverCompare(t *testing.T) {
+	// Ordered by precedence, following the example at semver.org.
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"2.0.0",
+		"10.0.0",
+	}
+
+	for i := range ordered {
+		for j := range ordered {
+			a, err := ParseSemver(ordered[i])
+			if err != nil {
+				t.Fatalf("ParseSemver(%q) returned error: %v", ordered[i], err)
+			}
+			b, err := ParseSemver(ordered[j])
+			if err != nil {
+				t.Fatalf("ParseSemver(%q) returned error: %v", ordered[j], err)
+			}
+
+			want := compareUint(uint64(i), uint64(j))
+			if got := a.Compare(b); got != want {
+				t.Errorf("%s.Compare(%s) = %d; want %d", a, b, got, want)
+			}
+			if got := a.Less(b); got != (i < j) {
+				t.Errorf("%s.Less(%s) = %v; want %v", a, b, got, i < j)
+			}
+		}
+	}
+
+	t.Run("build metadata is ignored", func(t *testing.T) {
+		a, _ := ParseSemver("1.0.0+build.1")
+		b, _ := ParseSemver("1.0.0+build.2")
+		if c := a.Compare(b); c != 0 {
+			t.Errorf("%s.Compare(%s) = %d; want 0", a, b, c)
+		}
+	})
+}
+
+func TestParsedVersion(t *testing.T) {
+	v, err := ParsedVersion()
+	if err != nil {
+		t.Fatalf("ParsedVersion() returned error: %v", err)
+	}
+	if v.String() != Version {
+		t.Errorf("ParsedVersion().String() = %q; want %q", v.String(), Version)
+	}
+}
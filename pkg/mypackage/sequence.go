@@ -0,0 +1,62 @@
+package mypackage
+
+import "math"
+
+// Range returns the values start, start+step, start+2*step, ... up to but
+// not including stop. A negative step produces a descending range, and
+// start == stop produces an empty, non-nil slice.
+// It returns ErrInvalidRange if step is zero (or NaN) or points away from
+// stop. Integer ranges end early rather than wrap if the next value would
+// overflow T.
+func Range[T Number](start, stop, step T) ([]T, error) {
+	ascending := step > 0
+	if !ascending && !(step < 0) {
+		return nil, ErrInvalidRange
+	}
+	if (ascending && start > stop) || (!ascending && start < stop) {
+		return nil, ErrInvalidRange
+	}
+
+	result := make([]T, 0)
+	for i := 0; ; i++ {
+		// Computing each value from start avoids accumulating float error.
+		v := start + T(i)*step
+		if ascending && v >= stop || !ascending && v <= stop {
+			break
+		}
+		if n := len(result); n > 0 && (ascending && v <= result[n-1] || !ascending && v >= result[n-1]) {
+			break
+		}
+		result = append(result, v)
+	}
+	return result, nil
+}
+
+// LinSpace returns n evenly spaced values from start to stop inclusive,
+// matching NumPy's linspace: the first value is start and the last is
+// exactly stop. For n == 1 it returns just [start]. Finite endpoints give
+// finite values even when stop-start overflows float64.
+// It returns ErrInvalidRange if n < 1.
+func LinSpace(start, stop float64, n int) ([]float64, error) {
+	if n < 1 {
+		return nil, ErrInvalidRange
+	}
+	result := make([]float64, n)
+	result[0] = start
+	if n == 1 {
+		return result, nil
+	}
+
+	step := (stop - start) / float64(n-1)
+	for i := 1; i < n-1; i++ {
+		if math.IsInf(step, 0) {
+			// stop-start overflowed, which needs endpoints of opposite signs,
+			// so interpolating between them directly cannot overflow.
+			result[i] = Lerp(start, stop, float64(i)/float64(n-1))
+		} else {
+			result[i] = float64(i)*step + start
+		}
+	}
+	result[n-1] = stop
+	return result, nil
+}
@@ -0,0 +1,129 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestRange(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			name              string
+			start, stop, step int
+			expected          []int
+			err               error
+		}{
+			{"ascending", 0, 5, 1, []int{0, 1, 2, 3, 4}, nil},
+			{"ascending by two", 1, 8, 2, []int{1, 3, 5, 7}, nil},
+			{"descending", 5, 0, -1, []int{5, 4, 3, 2, 1}, nil},
+			{"descending by three", 10, -1, -3, []int{10, 7, 4, 1}, nil},
+			{"empty", 3, 3, 1, []int{}, nil},
+			{"zero step", 0, 5, 0, nil, ErrInvalidRange},
+			{"step away from stop", 0, 5, -1, nil, ErrInvalidRange},
+			{"descending with positive step", 5, 0, 1, nil, ErrInvalidRange},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := Range(tt.start, tt.stop, tt.step)
+				if !errors.Is(err, tt.err) {
+					t.Fatalf("Range(%d, %d, %d) error = %v; want %v", tt.start, tt.stop, tt.step, err, tt.err)
+				}
+				if tt.err == nil && (result == nil || !equalSlices(result, tt.expected)) {
+					t.Errorf("Range(%d, %d, %d) = %#v; want %v", tt.start, tt.stop, tt.step, result, tt.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("float step", func(t *testing.T) {
+		result, err := Range(0.0, 1.0, 0.25)
+		if err != nil {
+			t.Fatalf("Range(0, 1, 0.25) unexpected error: %v", err)
+		}
+		expected := []float64{0, 0.25, 0.5, 0.75}
+		if !equalSlices(result, expected) {
+			t.Errorf("Range(0, 1, 0.25) = %v; want %v", result, expected)
+		}
+	})
+
+	t.Run("float step without drift", func(t *testing.T) {
+		result, err := Range(0.0, 1.0, 0.1)
+		if err != nil {
+			t.Fatalf("Range(0, 1, 0.1) unexpected error: %v", err)
+		}
+		if len(result) != 10 {
+			t.Errorf("Range(0, 1, 0.1) = %v; want 10 values", result)
+		}
+	})
+
+	t.Run("stops before overflow", func(t *testing.T) {
+		result, err := Range[int8](120, 127, 5)
+		if err != nil {
+			t.Fatalf("Range[int8](120, 127, 5) unexpected error: %v", err)
+		}
+		expected := []int8{120, 125}
+		if !equalSlices(result, expected) {
+			t.Errorf("Range[int8](120, 127, 5) = %v; want %v", result, expected)
+		}
+	})
+}
+
+func TestLinSpace(t *testing.T) {
+	tests := []struct {
+		name        string
+		start, stop float64
+		n           int
+		expected    []float64
+		err         error
+	}{
+		{"unit interval", 0, 1, 5, []float64{0, 0.25, 0.5, 0.75, 1}, nil},
+		{"offset interval", 2, 3, 5, []float64{2, 2.25, 2.5, 2.75, 3}, nil},
+		{"descending", 10, 0, 3, []float64{10, 5, 0}, nil},
+		{"negative to positive", -1, 1, 5, []float64{-1, -0.5, 0, 0.5, 1}, nil},
+		{"two points", 3, 7, 2, []float64{3, 7}, nil},
+		{"single point", 3, 7, 1, []float64{3}, nil},
+		{"equal endpoints", 4, 4, 3, []float64{4, 4, 4}, nil},
+		{"full float64 range", -math.MaxFloat64, math.MaxFloat64, 3, []float64{-math.MaxFloat64, 0, math.MaxFloat64}, nil},
+		{"zero count", 0, 1, 0, nil, ErrInvalidRange},
+		{"negative count", 0, 1, -2, nil, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := LinSpace(tt.start, tt.stop, tt.n)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("LinSpace(%v, %v, %d) error = %v; want %v", tt.start, tt.stop, tt.n, err, tt.err)
+			}
+			if tt.err == nil && !equalSlices(result, tt.expected) {
+				t.Errorf("LinSpace(%v, %v, %d) = %v; want %v", tt.start, tt.stop, tt.n, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("ends exactly at stop", func(t *testing.T) {
+		result, err := LinSpace(0, 1, 11)
+		if err != nil {
+			t.Fatalf("LinSpace(0, 1, 11) unexpected error: %v", err)
+		}
+		if len(result) != 11 || result[0] != 0 || result[10] != 1 {
+			t.Errorf("LinSpace(0, 1, 11) = %v; want 11 values from 0 to 1", result)
+		}
+		if !FloatEqual(result[3], 0.3, 1e-15) {
+			t.Errorf("LinSpace(0, 1, 11)[3] = %v; want 0.3", result[3])
+		}
+	})
+
+	t.Run("wide range stays finite", func(t *testing.T) {
+		result, err := LinSpace(-math.MaxFloat64, math.MaxFloat64, 7)
+		if err != nil {
+			t.Fatalf("LinSpace(-MaxFloat64, MaxFloat64, 7) unexpected error: %v", err)
+		}
+		for i, v := range result {
+			if math.IsInf(v, 0) || (i > 0 && v <= result[i-1]) {
+				t.Fatalf("LinSpace(-MaxFloat64, MaxFloat64, 7) = %v; want finite ascending values", result)
+			}
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package mypackage
+
+import "math"
+
+// AbsInt returns the absolute value of an integer.
+// The absolute value of math.MinInt is not representable as an int, so
+// AbsInt(math.MinInt) wraps around and returns math.MinInt.
+// Use AbsIntChecked to detect that case.
+func AbsInt(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+// AbsIntChecked returns the absolute value of an integer, or an
+// *ArithmeticError wrapping ErrOverflow if a is math.MinInt.
+func AbsIntChecked(a int) (int, error) {
+	if a == math.MinInt {
+		return 0, &ArithmeticError{Op: "AbsIntChecked", Err: ErrOverflow}
+	}
+	return AbsInt(a), nil
+}
+
+// AbsFloat returns the absolute value of a float64 number.
+// AbsFloat(-0) returns +0, AbsFloat(±Inf) returns +Inf, and AbsFloat(NaN) returns NaN.
+func AbsFloat(a float64) float64 {
+	return CopySign(a, 1)
+}
+
+// CopySign returns a value with the magnitude of magnitude and the sign of
+// sign. The sign bit is copied as is, so a negative zero or a NaN with its
+// sign bit set counts as negative: CopySign(3, -0.0) is -3.
+func CopySign(magnitude, sign float64) float64 {
+	return math.Copysign(magnitude, sign)
+}
+
+// Sign returns -1 if v is negative, +1 if v is positive, and 0 if v is zero.
+// For floating-point types, negative zero is treated as zero and returns 0,
+// and NaN, which is neither negative nor positive, also returns 0.
+func Sign[T Number](v T) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	}
+	return 0
+}
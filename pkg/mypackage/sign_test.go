@@ -0,0 +1,186 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestAbsInt(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        int
+		expected int
+	}{
+		{"positive", 5, 5},
+		{"negative", -5, 5},
+		{"zero", 0, 0},
+		{"max int", math.MaxInt, math.MaxInt},
+		{"min int plus one", math.MinInt + 1, math.MaxInt},
+		{"min int wraps", math.MinInt, math.MinInt},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AbsInt(tt.a)
+			if result != tt.expected {
+				t.Errorf("AbsInt(%d) = %d; want %d", tt.a, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAbsIntChecked(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        int
+		expected int
+		err      error
+	}{
+		{"positive", 5, 5, nil},
+		{"negative", -5, 5, nil},
+		{"zero", 0, 0, nil},
+		{"min int plus one", math.MinInt + 1, math.MaxInt, nil},
+		{"min int", math.MinInt, 0, ErrOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := AbsIntChecked(tt.a)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("AbsIntChecked(%d) error = %v; want %v", tt.a, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("AbsIntChecked(%d) = %d; want %d", tt.a, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAbsFloat(t *testing.T) {
+	tests := []struct {
+		name     string
+		a        float64
+		expected float64
+	}{
+		{"positive", 2.5, 2.5},
+		{"negative", -2.5, 2.5},
+		{"zero", 0, 0},
+		{"positive infinity", math.Inf(1), math.Inf(1)},
+		{"negative infinity", math.Inf(-1), math.Inf(1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := AbsFloat(tt.a)
+			if result != tt.expected {
+				t.Errorf("AbsFloat(%f) = %f; want %f", tt.a, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("negative zero", func(t *testing.T) {
+		result := AbsFloat(math.Copysign(0, -1))
+		if result != 0 || math.Signbit(result) {
+			t.Errorf("AbsFloat(-0) = %f (signbit %v); want +0", result, math.Signbit(result))
+		}
+	})
+
+	t.Run("NaN", func(t *testing.T) {
+		if result := AbsFloat(math.NaN()); !math.IsNaN(result) {
+			t.Errorf("AbsFloat(NaN) = %f; want NaN", result)
+		}
+	})
+}
+
+func TestSign(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			v        int
+			expected int
+		}{
+			{-5, -1},
+			{0, 0},
+			{5, 1},
+			{math.MinInt, -1},
+			{math.MaxInt, 1},
+		}
+		for _, tt := range tests {
+			if result := Sign(tt.v); result != tt.expected {
+				t.Errorf("Sign(%d) = %d; want %d", tt.v, result, tt.expected)
+			}
+		}
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		if result := Sign[uint8](0); result != 0 {
+			t.Errorf("Sign[uint8](0) = %d; want 0", result)
+		}
+		if result := Sign[uint8](200); result != 1 {
+			t.Errorf("Sign[uint8](200) = %d; want 1", result)
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			v        float64
+			expected int
+		}{
+			{"negative", -0.5, -1},
+			{"zero", 0, 0},
+			{"positive", 0.5, 1},
+			{"negative zero", math.Copysign(0, -1), 0},
+			{"NaN", math.NaN(), 0},
+			{"positive infinity", math.Inf(1), 1},
+			{"negative infinity", math.Inf(-1), -1},
+			{"smallest subnormal", math.SmallestNonzeroFloat64, 1},
+		}
+		for _, tt := range tests {
+			if result := Sign(tt.v); result != tt.expected {
+				t.Errorf("Sign(%s) = %d; want %d", tt.name, result, tt.expected)
+			}
+		}
+	})
+}
+
+func TestCopySign(t *testing.T) {
+	negZero := math.Copysign(0, -1)
+
+	tests := []struct {
+		name            string
+		magnitude, sign float64
+		expected        float64
+	}{
+		{"positive to negative", 3, -1, -3},
+		{"negative to positive", -3, 1, 3},
+		{"keeps positive", 3, 2, 3},
+		{"keeps negative", -3, -2, -3},
+		{"negative zero sign", 3, negZero, -3},
+		{"positive zero sign", -3, 0, 3},
+		{"infinity", math.Inf(1), -1, math.Inf(-1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := CopySign(tt.magnitude, tt.sign); result != tt.expected {
+				t.Errorf("CopySign(%v, %v) = %v; want %v", tt.magnitude, tt.sign, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("zero magnitude takes the sign", func(t *testing.T) {
+		if result := CopySign(0, -5); result != 0 || !math.Signbit(result) {
+			t.Errorf("CopySign(0, -5) = %v (signbit %v); want -0", result, math.Signbit(result))
+		}
+		if result := CopySign(negZero, 5); result != 0 || math.Signbit(result) {
+			t.Errorf("CopySign(-0, 5) = %v (signbit %v); want +0", result, math.Signbit(result))
+		}
+	})
+
+	t.Run("NaN magnitude", func(t *testing.T) {
+		if result := CopySign(math.NaN(), -1); !math.IsNaN(result) || !math.Signbit(result) {
+			t.Errorf("CopySign(NaN, -1) = %v (signbit %v); want negative NaN", result, math.Signbit(result))
+		}
+	})
+}
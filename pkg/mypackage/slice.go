@@ -0,0 +1,169 @@
+package mypackage
+
+import "slices"
+
+// MapSlice returns a new slice holding fn applied to each item, in order.
+// A nil or empty input yields an empty, non-nil slice.
+func MapSlice[T, R any](items []T, fn func(T) R) []R {
+	result := make([]R, len(items))
+	for i, item := range items {
+		result[i] = fn(item)
+	}
+	return result
+}
+
+// Filter returns the items for which pred returns true, in their original
+// order. When nothing matches it returns an empty, non-nil slice.
+func Filter[T any](items []T, pred func(T) bool) []T {
+	result := make([]T, 0)
+	for _, item := range items {
+		if pred(item) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// Contains reports whether target is present in items.
+func Contains[T comparable](items []T, target T) bool {
+	return IndexOf(items, target) >= 0
+}
+
+// IndexOf returns the index of the first occurrence of target in items,
+// or -1 if it is not present.
+func IndexOf[T comparable](items []T, target T) int {
+	return slices.Index(items, target)
+}
+
+// Deduplicate returns a new slice with repeated items removed, keeping the
+// first occurrence of each in its original position. A nil or empty input
+// yields an empty, non-nil slice.
+func Deduplicate[T comparable](items []T) []T {
+	seen := make(map[T]struct{}, len(items))
+	result := make([]T, 0, len(items))
+	for _, item := range items {
+		if _, ok := seen[item]; ok {
+			continue
+		}
+		seen[item] = struct{}{}
+		result = append(result, item)
+	}
+	return result
+}
+
+// Chunk splits items into consecutive sub-slices of size elements; the final
+// chunk holds the remainder and may be shorter. The chunks share items'
+// backing array, but their capacity is capped so appending to one never
+// overwrites the next. An empty input yields an empty, non-nil slice.
+// It returns ErrInvalidRange if size < 1.
+func Chunk[T any](items []T, size int) ([][]T, error) {
+	if size < 1 {
+		return nil, ErrInvalidRange
+	}
+	chunks := make([][]T, 0, (len(items)+size-1)/size)
+	for start := 0; start < len(items); start += size {
+		end := min(start+size, len(items))
+		chunks = append(chunks, items[start:end:end])
+	}
+	return chunks, nil
+}
+
+// Flatten concatenates the inner slices of nested, in order, into a new
+// slice. Nil and empty inner slices contribute nothing, and an empty outer
+// slice yields an empty, non-nil slice.
+func Flatten[T any](nested [][]T) []T {
+	total := 0
+	for _, inner := range nested {
+		total += len(inner)
+	}
+	result := make([]T, 0, total)
+	for _, inner := range nested {
+		result = append(result, inner...)
+	}
+	return result
+}
+
+// Pair holds two values of possibly different types, as produced by Zip.
+type Pair[A, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs a[i] with b[i] for every index. Empty inputs yield an empty,
+// non-nil slice. It returns ErrLengthMismatch if a and b have different
+// lengths.
+func Zip[A, B any](a []A, b []B) ([]Pair[A, B], error) {
+	if len(a) != len(b) {
+		return nil, ErrLengthMismatch
+	}
+	pairs := make([]Pair[A, B], len(a))
+	for i := range a {
+		pairs[i] = Pair[A, B]{First: a[i], Second: b[i]}
+	}
+	return pairs, nil
+}
+
+// GroupBy groups items by the key keyFn returns for each, keeping items in
+// their original order within each group. An empty input yields an empty,
+// non-nil map.
+func GroupBy[T any, K comparable](items []T, keyFn func(T) K) map[K][]T {
+	groups := make(map[K][]T)
+	for _, item := range items {
+		key := keyFn(item)
+		groups[key] = append(groups[key], item)
+	}
+	return groups
+}
+
+// Partition splits items in a single pass into those for which pred
+// returns true and the rest, preserving order in both. Both results are
+// non-nil, even when empty.
+func Partition[T any](items []T, pred func(T) bool) (matched, rest []T) {
+	matched, rest = make([]T, 0), make([]T, 0)
+	for _, item := range items {
+		if pred(item) {
+			matched = append(matched, item)
+		} else {
+			rest = append(rest, item)
+		}
+	}
+	return matched, rest
+}
+
+// All reports whether pred returns true for every item. It stops at the
+// first item that fails, and is true for an empty slice.
+func All[T any](items []T, pred func(T) bool) bool {
+	for _, item := range items {
+		if !pred(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Any reports whether pred returns true for at least one item. It stops at
+// the first match, and is false for an empty slice.
+func Any[T any](items []T, pred func(T) bool) bool {
+	for _, item := range items {
+		if pred(item) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns how many items pred returns true for.
+func Count[T any](items []T, pred func(T) bool) int {
+	n := 0
+	for _, item := range items {
+		if pred(item) {
+			n++
+		}
+	}
+	return n
+}
+
+// CountValue returns how many items are equal to target.
+func CountValue[T comparable](items []T, target T) int {
+	return Count(items, func(item T) bool { return item == target })
+}
@@ -0,0 +1,386 @@
+package mypackage
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMapSlice(t *testing.T) {
+	t.Run("int to string", func(t *testing.T) {
+		result := MapSlice([]int{1, -2, 30}, strconv.Itoa)
+		expected := []string{"1", "-2", "30"}
+		if !equalSlices(result, expected) {
+			t.Errorf("MapSlice([1 -2 30], Itoa) = %q; want %q", result, expected)
+		}
+	})
+
+	t.Run("preserves order", func(t *testing.T) {
+		items := []int{5, 4, 3, 2, 1}
+		result := MapSlice(items, func(v int) int { return v * v })
+		expected := []int{25, 16, 9, 4, 1}
+		if !equalSlices(result, expected) {
+			t.Errorf("MapSlice(%v, square) = %v; want %v", items, result, expected)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		for _, items := range [][]int{nil, {}} {
+			result := MapSlice(items, strconv.Itoa)
+			if result == nil || len(result) != 0 {
+				t.Errorf("MapSlice(%#v, Itoa) = %#v; want empty non-nil slice", items, result)
+			}
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	tests := []struct {
+		name     string
+		items    []int
+		expected []int
+	}{
+		{"mixed signs", []int{3, -1, 0, 7, -4, 2}, []int{3, 7, 2}},
+		{"everything matches", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"nothing matches", []int{-1, 0, -5}, []int{}},
+		{"empty slice", []int{}, []int{}},
+		{"nil slice", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Filter(tt.items, isPositive)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("Filter(%v, isPositive) = %#v; want %v", tt.items, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestIndexOf(t *testing.T) {
+	items := []string{"a", "b", "c", "b"}
+
+	tests := []struct {
+		name     string
+		items    []string
+		target   string
+		expected int
+	}{
+		{"first position", items, "a", 0},
+		{"middle position", items, "c", 2},
+		{"first of duplicates", items, "b", 1},
+		{"last position", []string{"x", "y", "z"}, "z", 2},
+		{"absent", items, "d", -1},
+		{"empty slice", []string{}, "a", -1},
+		{"nil slice", nil, "a", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := IndexOf(tt.items, tt.target); result != tt.expected {
+				t.Errorf("IndexOf(%q, %q) = %d; want %d", tt.items, tt.target, result, tt.expected)
+			}
+			if result := Contains(tt.items, tt.target); result != (tt.expected >= 0) {
+				t.Errorf("Contains(%q, %q) = %v; want %v", tt.items, tt.target, result, tt.expected >= 0)
+			}
+		})
+	}
+}
+
+func TestDeduplicate(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		expected []int
+	}{
+		{"already unique", []int{3, 1, 2}, []int{3, 1, 2}},
+		{"repeated elements", []int{4, 2, 4, 3, 2, 1, 4}, []int{4, 2, 3, 1}},
+		{"all identical", []int{7, 7, 7, 7}, []int{7}},
+		{"empty slice", []int{}, []int{}},
+		{"nil slice", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Deduplicate(tt.items)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("Deduplicate(%v) = %#v; want %v", tt.items, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("does not modify input", func(t *testing.T) {
+		items := []string{"b", "a", "b"}
+		Deduplicate(items)
+		if expected := []string{"b", "a", "b"}; !equalSlices(items, expected) {
+			t.Errorf("Deduplicate() mutated input: got %q; want %q", items, expected)
+		}
+	})
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []int
+		size     int
+		expected [][]int
+		err      error
+	}{
+		{"even split", []int{1, 2, 3, 4, 5, 6}, 2, [][]int{{1, 2}, {3, 4}, {5, 6}}, nil},
+		{"uneven split", []int{1, 2, 3, 4, 5}, 2, [][]int{{1, 2}, {3, 4}, {5}}, nil},
+		{"size larger than slice", []int{1, 2, 3}, 10, [][]int{{1, 2, 3}}, nil},
+		{"size one", []int{1, 2}, 1, [][]int{{1}, {2}}, nil},
+		{"empty slice", []int{}, 3, [][]int{}, nil},
+		{"zero size", []int{1, 2}, 0, nil, ErrInvalidRange},
+		{"negative size", []int{1, 2}, -1, nil, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Chunk(tt.items, tt.size)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Chunk(%v, %d) error = %v; want %v", tt.items, tt.size, err, tt.err)
+			}
+			if tt.err != nil {
+				return
+			}
+			if result == nil || len(result) != len(tt.expected) {
+				t.Fatalf("Chunk(%v, %d) = %#v; want %v", tt.items, tt.size, result, tt.expected)
+			}
+			for i := range result {
+				if !equalSlices(result[i], tt.expected[i]) {
+					t.Errorf("Chunk(%v, %d) = %v; want %v", tt.items, tt.size, result, tt.expected)
+					break
+				}
+			}
+		})
+	}
+
+	t.Run("appending to a chunk leaves the next intact", func(t *testing.T) {
+		items := []int{1, 2, 3, 4}
+		chunks, err := Chunk(items, 2)
+		if err != nil {
+			t.Fatalf("Chunk(%v, 2) returned error: %v", items, err)
+		}
+		_ = append(chunks[0], 99)
+		if expected := []int{1, 2, 3, 4}; !equalSlices(items, expected) {
+			t.Errorf("append to chunk overwrote input: got %v; want %v", items, expected)
+		}
+	})
+}
+
+func TestFlatten(t *testing.T) {
+	tests := []struct {
+		name     string
+		nested   [][]int
+		expected []int
+	}{
+		{"typical nesting", [][]int{{1, 2}, {3}, {4, 5, 6}}, []int{1, 2, 3, 4, 5, 6}},
+		{"single inner slice", [][]int{{7, 8}}, []int{7, 8}},
+		{"nil inner slices", [][]int{nil, {1}, nil, {2, 3}, nil}, []int{1, 2, 3}},
+		{"empty inner slices", [][]int{{}, {}, {4}}, []int{4}},
+		{"only nil inner slices", [][]int{nil, nil}, []int{}},
+		{"empty outer slice", [][]int{}, []int{}},
+		{"nil outer slice", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Flatten(tt.nested)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("Flatten(%v) = %#v; want %v", tt.nested, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestZip(t *testing.T) {
+	t.Run("equal lengths", func(t *testing.T) {
+		result, err := Zip([]string{"a", "b", "c"}, []int{1, 2, 3})
+		if err != nil {
+			t.Fatalf("Zip() returned error: %v", err)
+		}
+		expected := []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}}
+		if !equalSlices(result, expected) {
+			t.Errorf("Zip([a b c], [1 2 3]) = %v; want %v", result, expected)
+		}
+	})
+
+	t.Run("mismatched lengths", func(t *testing.T) {
+		if _, err := Zip([]int{1, 2}, []int{1}); !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("Zip([1 2], [1]) error = %v; want %v", err, ErrLengthMismatch)
+		}
+		if _, err := Zip([]int{}, []int{1}); !errors.Is(err, ErrLengthMismatch) {
+			t.Errorf("Zip([], [1]) error = %v; want %v", err, ErrLengthMismatch)
+		}
+	})
+
+	t.Run("empty inputs", func(t *testing.T) {
+		result, err := Zip[int, string](nil, []string{})
+		if err != nil {
+			t.Fatalf("Zip(nil, []) returned error: %v", err)
+		}
+		if result == nil || len(result) != 0 {
+			t.Errorf("Zip(nil, []) = %#v; want empty non-nil slice", result)
+		}
+	})
+}
+
+func TestGroupBy(t *testing.T) {
+	parity := func(v int) string {
+		if v%2 == 0 {
+			return "even"
+		}
+		return "odd"
+	}
+
+	t.Run("by parity", func(t *testing.T) {
+		groups := GroupBy([]int{5, 2, 8, 3, 1, 4}, parity)
+		if len(groups) != 2 {
+			t.Fatalf("GroupBy() returned %d groups; want 2: %v", len(groups), groups)
+		}
+		if expected := []int{2, 8, 4}; !equalSlices(groups["even"], expected) {
+			t.Errorf("GroupBy()[even] = %v; want %v", groups["even"], expected)
+		}
+		if expected := []int{5, 3, 1}; !equalSlices(groups["odd"], expected) {
+			t.Errorf("GroupBy()[odd] = %v; want %v", groups["odd"], expected)
+		}
+	})
+
+	t.Run("all in one group", func(t *testing.T) {
+		items := []string{"ab", "cd", "ef"}
+		groups := GroupBy(items, func(s string) int { return len(s) })
+		if len(groups) != 1 || !equalSlices(groups[2], items) {
+			t.Errorf("GroupBy(%q, len) = %v; want map[2:%v]", items, groups, items)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		for _, items := range [][]int{nil, {}} {
+			groups := GroupBy(items, parity)
+			if groups == nil || len(groups) != 0 {
+				t.Errorf("GroupBy(%#v) = %#v; want empty non-nil map", items, groups)
+			}
+		}
+	})
+}
+
+func TestPartition(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	tests := []struct {
+		name            string
+		items           []int
+		expectedMatched []int
+		expectedRest    []int
+	}{
+		{"mixed signs", []int{3, -1, 0, 7, -4, 2}, []int{3, 7, 2}, []int{-1, 0, -4}},
+		{"everything matches", []int{1, 2}, []int{1, 2}, []int{}},
+		{"nothing matches", []int{-1, -2}, []int{}, []int{-1, -2}},
+		{"empty slice", []int{}, []int{}, []int{}},
+		{"nil slice", nil, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, rest := Partition(tt.items, isPositive)
+			if matched == nil || !equalSlices(matched, tt.expectedMatched) {
+				t.Errorf("Partition(%v) matched = %#v; want %v", tt.items, matched, tt.expectedMatched)
+			}
+			if rest == nil || !equalSlices(rest, tt.expectedRest) {
+				t.Errorf("Partition(%v) rest = %#v; want %v", tt.items, rest, tt.expectedRest)
+			}
+		})
+	}
+}
+
+func TestAllAny(t *testing.T) {
+	isPositive := func(v int) bool { return v > 0 }
+
+	tests := []struct {
+		name        string
+		items       []int
+		expectedAll bool
+		expectedAny bool
+	}{
+		{"all true", []int{1, 2, 3}, true, true},
+		{"all false", []int{-1, 0, -3}, false, false},
+		{"mixed", []int{-1, 2, 0}, false, true},
+		{"empty slice", []int{}, true, false},
+		{"nil slice", nil, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := All(tt.items, isPositive); result != tt.expectedAll {
+				t.Errorf("All(%v, isPositive) = %v; want %v", tt.items, result, tt.expectedAll)
+			}
+			if result := Any(tt.items, isPositive); result != tt.expectedAny {
+				t.Errorf("Any(%v, isPositive) = %v; want %v", tt.items, result, tt.expectedAny)
+			}
+		})
+	}
+
+	t.Run("short-circuits", func(t *testing.T) {
+		calls := 0
+		counting := func(v int) bool { calls++; return v > 0 }
+		All([]int{1, -1, 2, 3}, counting)
+		if calls != 2 {
+			t.Errorf("All() called pred %d times; want 2", calls)
+		}
+		calls = 0
+		Any([]int{-1, 1, 2, 3}, counting)
+		if calls != 2 {
+			t.Errorf("Any() called pred %d times; want 2", calls)
+		}
+	})
+}
+
+func TestCount(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	tests := []struct {
+		name     string
+		items    []int
+		expected int
+	}{
+		{"several matches", []int{1, 2, 3, 4, 6}, 3},
+		{"no matches", []int{1, 3, 5}, 0},
+		{"all match", []int{2, 4}, 2},
+		{"empty slice", []int{}, 0},
+		{"nil slice", nil, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := Count(tt.items, isEven); result != tt.expected {
+				t.Errorf("Count(%v, isEven) = %d; want %d", tt.items, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCountValue(t *testing.T) {
+	tests := []struct {
+		name     string
+		items    []string
+		target   string
+		expected int
+	}{
+		{"several matches", []string{"a", "b", "a", "c", "a"}, "a", 3},
+		{"no matches", []string{"a", "b"}, "z", 0},
+		{"all match", []string{"x", "x"}, "x", 2},
+		{"empty slice", []string{}, "a", 0},
+		{"nil slice", nil, "a", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := CountValue(tt.items, tt.target); result != tt.expected {
+				t.Errorf("CountValue(%q, %q) = %d; want %d", tt.items, tt.target, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package mypackage
+
+import "slices"
+
+// SortNumbers returns an ascending copy of values, leaving values untouched.
+// NaNs are placed first, before all other values, as slices.Sort does.
+// A nil or empty input yields an empty, non-nil slice.
+func SortNumbers[T Number](values []T) []T {
+	sorted := sortedCopy(values)
+	if sorted == nil {
+		sorted = []T{}
+	}
+	return sorted
+}
+
+// SortNumbersDesc returns a descending copy of values, leaving values
+// untouched. It is the reverse of SortNumbers, so NaNs are placed last.
+func SortNumbersDesc[T Number](values []T) []T {
+	sorted := SortNumbers(values)
+	slices.Reverse(sorted)
+	return sorted
+}
@@ -0,0 +1,86 @@
+package mypackage
+
+import (
+	"math"
+	"testing"
+)
+
+func TestSortNumbers(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"unsorted", []int{3, -1, 2, 0}, []int{-1, 0, 2, 3}},
+		{"already sorted", []int{1, 2, 3}, []int{1, 2, 3}},
+		{"reversed", []int{3, 2, 1}, []int{1, 2, 3}},
+		{"duplicates", []int{2, 1, 2, 1}, []int{1, 1, 2, 2}},
+		{"empty", []int{}, []int{}},
+		{"nil", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SortNumbers(tt.values)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("SortNumbers(%v) = %#v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("does not modify input", func(t *testing.T) {
+		values := []float64{2.5, -1, 0.5}
+		SortNumbers(values)
+		if expected := []float64{2.5, -1, 0.5}; !equalSlices(values, expected) {
+			t.Errorf("SortNumbers() mutated input: got %v; want %v", values, expected)
+		}
+	})
+
+	t.Run("NaN placed first", func(t *testing.T) {
+		result := SortNumbers([]float64{2, math.NaN(), -1, math.Inf(1), math.NaN()})
+		if len(result) != 5 || !math.IsNaN(result[0]) || !math.IsNaN(result[1]) {
+			t.Fatalf("SortNumbers() = %v; want NaNs first", result)
+		}
+		if rest := result[2:]; !equalSlices(rest, []float64{-1, 2, math.Inf(1)}) {
+			t.Errorf("SortNumbers() = %v; want [NaN NaN -1 2 +Inf]", result)
+		}
+	})
+}
+
+func TestSortNumbersDesc(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected []int
+	}{
+		{"unsorted", []int{3, -1, 2, 0}, []int{3, 2, 0, -1}},
+		{"ascending", []int{1, 2, 3}, []int{3, 2, 1}},
+		{"duplicates", []int{1, 2, 1}, []int{2, 1, 1}},
+		{"empty", []int{}, []int{}},
+		{"nil", nil, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := SortNumbersDesc(tt.values)
+			if result == nil || !equalSlices(result, tt.expected) {
+				t.Errorf("SortNumbersDesc(%v) = %#v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("does not modify input", func(t *testing.T) {
+		values := []int{1, 3, 2}
+		SortNumbersDesc(values)
+		if expected := []int{1, 3, 2}; !equalSlices(values, expected) {
+			t.Errorf("SortNumbersDesc() mutated input: got %v; want %v", values, expected)
+		}
+	})
+
+	t.Run("NaN placed last", func(t *testing.T) {
+		result := SortNumbersDesc([]float64{math.NaN(), 1, 5})
+		if len(result) != 3 || result[0] != 5 || result[1] != 1 || !math.IsNaN(result[2]) {
+			t.Errorf("SortNumbersDesc() = %v; want [5 1 NaN]", result)
+		}
+	})
+}
@@ -0,0 +1,216 @@
+package mypackage
+
+import (
+	"math"
+	"slices"
+)
+
+// Mean returns the arithmetic mean of values as a float64.
+// Values are accumulated in float64, so integer inputs do not overflow.
+// It returns ErrEmptyInput if values is empty.
+func Mean[T Number](values []T) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	var total float64
+	for _, v := range values {
+		total += float64(v)
+	}
+	return total / float64(len(values)), nil
+}
+
+// Median returns the median of values as a float64.
+// For an even number of values it is the mean of the two middle values.
+// The caller's slice is not modified. It returns ErrEmptyInput if values
+// is empty.
+func Median[T Number](values []T) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	sorted := sortedCopy(values)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return float64(sorted[mid]), nil
+	}
+	return (float64(sorted[mid-1]) + float64(sorted[mid])) / 2, nil
+}
+
+// Variance returns the variance of values.
+// If sample is true it returns the sample variance, dividing by N-1;
+// otherwise it returns the population variance, dividing by N.
+// It returns ErrEmptyInput if values is empty, and ErrInsufficientData if
+// sample is true and values has a single element.
+func Variance[T Number](values []T, sample bool) (float64, error) {
+	mean, err := Mean(values)
+	if err != nil {
+		return 0, err
+	}
+	n := len(values)
+	if sample {
+		if n < 2 {
+			return 0, ErrInsufficientData
+		}
+		n--
+	}
+	var squares float64
+	for _, v := range values {
+		d := float64(v) - mean
+		squares += d * d
+	}
+	return squares / float64(n), nil
+}
+
+// StdDev returns the standard deviation of values, the square root of
+// Variance. The sample flag and errors are the same as for Variance.
+func StdDev[T Number](values []T, sample bool) (float64, error) {
+	variance, err := Variance(values, sample)
+	if err != nil {
+		return 0, err
+	}
+	return math.Sqrt(variance), nil
+}
+
+// Percentile returns the p-th percentile of values, for p in [0, 100],
+// interpolating linearly between the two nearest ranks (the same method as
+// NumPy's default). Percentile(values, 50) equals Median(values), and p of 0
+// and 100 return the minimum and maximum. The caller's slice is not modified.
+// It returns ErrEmptyInput if values is empty, and ErrInvalidRange if p is
+// outside [0, 100] or NaN.
+func Percentile[T Number](values []T, p float64) (float64, error) {
+	if len(values) == 0 {
+		return 0, ErrEmptyInput
+	}
+	if !(p >= 0 && p <= 100) {
+		return 0, ErrInvalidRange
+	}
+	sorted := sortedCopy(values)
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return float64(sorted[lo]), nil
+	}
+	return Lerp(float64(sorted[lo]), float64(sorted[hi]), rank-float64(lo)), nil
+}
+
+// Histogram sorts values into bins equal-width bins spanning min(values) to
+// max(values), returning the count for each bin and the bins+1 bin edges.
+// Each bin includes its lower edge and excludes its upper edge, except the
+// last bin, which also includes the maximum, so a value exactly on an inner
+// edge is counted in the bin above it. If all values are equal the range is
+// widened to value-0.5..value+0.5, as NumPy does.
+// It returns ErrEmptyInput if values is empty, and ErrInvalidRange if
+// bins < 1 or values contains NaN or an infinity, which leave no finite
+// range to bin over.
+func Histogram[T Number](values []T, bins int) ([]int, []float64, error) {
+	if len(values) == 0 {
+		return nil, nil, ErrEmptyInput
+	}
+	if bins < 1 {
+		return nil, nil, ErrInvalidRange
+	}
+	lo, _ := Min(values...)
+	hi, _ := Max(values...)
+	start, stop := float64(lo), float64(hi)
+	if math.IsNaN(start) || math.IsNaN(stop) || math.IsInf(start, 0) || math.IsInf(stop, 0) {
+		return nil, nil, ErrInvalidRange
+	}
+	if start == stop {
+		start, stop = start-0.5, stop+0.5
+	}
+	edges, err := LinSpace(start, stop, bins+1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	counts := make([]int, bins)
+	// Offsets are halved when the full range overflows float64, so that
+	// the ratio below stays finite for every value between start and stop.
+	scale := 1.0
+	width := stop - start
+	if math.IsInf(width, 0) {
+		scale = 0.5
+		width = stop*scale - start*scale
+	}
+	for _, v := range values {
+		f := float64(v)
+		i := int((f*scale - start*scale) / width * float64(bins))
+		i = max(0, min(i, bins-1))
+		// The scaled index can be off by one from rounding; the edges decide.
+		if i < bins-1 && f >= edges[i+1] {
+			i++
+		} else if i > 0 && f < edges[i] {
+			i--
+		}
+		counts[i]++
+	}
+	return counts, edges, nil
+}
+
+// Normalize min-max scales values into [0, 1], mapping the smallest value
+// to 0 and the largest to 1. If all values are equal there is no range to
+// scale by, and every result is 0. The caller's slice is not modified.
+// It returns ErrEmptyInput if values is empty.
+func Normalize(values []float64) ([]float64, error) {
+	lo, err := Min(values...)
+	if err != nil {
+		return nil, err
+	}
+	hi, _ := Max(values...)
+	result := make([]float64, len(values))
+	if lo == hi {
+		return result, nil
+	}
+	for i, v := range values {
+		result[i] = (v - lo) / (hi - lo)
+	}
+	return result, nil
+}
+
+// Standardize rescales values to z-scores, (v - mean) / stddev, using the
+// population standard deviation, so the result has mean 0 and standard
+// deviation 1. If all values are equal every result is 0. The caller's
+// slice is not modified. It returns ErrEmptyInput if values is empty.
+func Standardize(values []float64) ([]float64, error) {
+	mean, err := Mean(values)
+	if err != nil {
+		return nil, err
+	}
+	std, _ := StdDev(values, false)
+	result := make([]float64, len(values))
+	if std == 0 {
+		return result, nil
+	}
+	for i, v := range values {
+		result[i] = (v - mean) / std
+	}
+	return result, nil
+}
+
+// MovingAverage returns the simple moving average of values over window
+// consecutive elements. The result has len(values)-window+1 elements, the
+// first being the mean of values[0:window]; a window of 1 returns a copy of
+// values. Each average is summed afresh, so rounding error does not build
+// up along the series.
+// It returns ErrInvalidRange if window < 1 and ErrInsufficientData if
+// window > len(values).
+func MovingAverage(values []float64, window int) ([]float64, error) {
+	if window < 1 {
+		return nil, ErrInvalidRange
+	}
+	if window > len(values) {
+		return nil, ErrInsufficientData
+	}
+	result := make([]float64, len(values)-window+1)
+	for i := range result {
+		result[i] = Sum(values[i:i+window]) / float64(window)
+	}
+	return result, nil
+}
+
+// sortedCopy returns an ascending copy of values, leaving values untouched.
+func sortedCopy[T Number](values []T) []T {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return sorted
+}
@@ -0,0 +1,426 @@
+package mypackage
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMean(t *testing.T) {
+	t.Run("int", func(t *testing.T) {
+		tests := []struct {
+			name     string
+			values   []int
+			expected float64
+		}{
+			{"single element", []int{4}, 4},
+			{"whole mean", []int{1, 2, 3}, 2},
+			{"fractional mean", []int{1, 2}, 1.5},
+			{"mixed signs", []int{-4, 4, 3}, 1},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				result, err := Mean(tt.values)
+				if err != nil {
+					t.Fatalf("Mean(%v) returned error: %v", tt.values, err)
+				}
+				if result != tt.expected {
+					t.Errorf("Mean(%v) = %f; want %f", tt.values, result, tt.expected)
+				}
+			})
+		}
+	})
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{1.5, 2.5, 3.5, 4.5}
+		result, err := Mean(values)
+		if err != nil || result != 3 {
+			t.Errorf("Mean(%v) = %f, %v; want 3, nil", values, result, err)
+		}
+	})
+
+	t.Run("integers that would overflow a sum", func(t *testing.T) {
+		values := []int64{math.MaxInt64, math.MaxInt64}
+		result, err := Mean(values)
+		if err != nil || result != float64(math.MaxInt64) {
+			t.Errorf("Mean(%v) = %f, %v; want %f, nil", values, result, err, float64(math.MaxInt64))
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if _, err := Mean([]int{}); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Mean([]) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
+
+func TestMedian(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []int
+		expected float64
+	}{
+		{"single element", []int{7}, 7},
+		{"odd length", []int{1, 3, 5}, 3},
+		{"even length", []int{1, 2, 3, 4}, 2.5},
+		{"unsorted odd", []int{9, 1, 5}, 5},
+		{"unsorted even", []int{10, -2, 4, 8}, 6},
+		{"duplicates", []int{2, 2, 2, 9}, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Median(tt.values)
+			if err != nil {
+				t.Fatalf("Median(%v) returned error: %v", tt.values, err)
+			}
+			if result != tt.expected {
+				t.Errorf("Median(%v) = %f; want %f", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("float64", func(t *testing.T) {
+		values := []float64{0.5, 3.5, 1.5, 2.5}
+		result, err := Median(values)
+		if err != nil || result != 2 {
+			t.Errorf("Median(%v) = %f, %v; want 2, nil", values, result, err)
+		}
+	})
+
+	t.Run("preserves input order", func(t *testing.T) {
+		values := []int{5, 3, 9, 1}
+		if _, err := Median(values); err != nil {
+			t.Fatalf("Median(%v) returned error: %v", values, err)
+		}
+		expected := []int{5, 3, 9, 1}
+		for i := range expected {
+			if values[i] != expected[i] {
+				t.Fatalf("Median() mutated input: got %v; want %v", values, expected)
+			}
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if _, err := Median([]float64{}); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Median([]) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
+
+func TestPercentile(t *testing.T) {
+	values := []int{15, 20, 35, 40, 50}
+
+	tests := []struct {
+		name     string
+		values   []int
+		p        float64
+		expected float64
+		err      error
+	}{
+		{"p50 is the median", values, 50, 35, nil},
+		{"p0 is the minimum", values, 0, 15, nil},
+		{"p100 is the maximum", values, 100, 50, nil},
+		{"exact rank", values, 25, 20, nil},
+		{"interpolated", values, 40, 29, nil},
+		{"interpolated p90", values, 90, 46, nil},
+		{"even length median", []int{1, 2, 3, 4}, 50, 2.5, nil},
+		{"single element", []int{7}, 99, 7, nil},
+		{"unsorted input", []int{50, 15, 40, 20, 35}, 40, 29, nil},
+		{"empty", []int{}, 50, 0, ErrEmptyInput},
+		{"p below zero", values, -1, 0, ErrInvalidRange},
+		{"p above 100", values, 100.5, 0, ErrInvalidRange},
+		{"p NaN", values, math.NaN(), 0, ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Percentile(tt.values, tt.p)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Percentile(%v, %v) error = %v; want %v", tt.values, tt.p, err, tt.err)
+			}
+			if tt.err == nil && !FloatEqual(result, tt.expected, 1e-9) {
+				t.Errorf("Percentile(%v, %v) = %v; want %v", tt.values, tt.p, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("preserves input order", func(t *testing.T) {
+		values := []float64{5, 3, 9, 1}
+		if _, err := Percentile(values, 75); err != nil {
+			t.Fatalf("Percentile(%v, 75) returned error: %v", values, err)
+		}
+		if expected := []float64{5, 3, 9, 1}; !equalSlices(values, expected) {
+			t.Fatalf("Percentile() mutated input: got %v; want %v", values, expected)
+		}
+	})
+}
+
+func TestHistogram(t *testing.T) {
+	tests := []struct {
+		name           string
+		values         []float64
+		bins           int
+		expectedCounts []int
+		expectedEdges  []float64
+	}{
+		{"uniform", []float64{0, 1, 2, 3, 4, 5, 6, 7}, 4, []int{2, 2, 2, 2}, []float64{0, 1.75, 3.5, 5.25, 7}},
+		{"inner edges go to the upper bin", []float64{0, 1, 2, 3, 4}, 4, []int{1, 1, 1, 2}, []float64{0, 1, 2, 3, 4}},
+		{"maximum goes to the last bin", []float64{0, 10}, 2, []int{1, 1}, []float64{0, 5, 10}},
+		{"skewed", []float64{1, 1, 1, 2, 9}, 2, []int{4, 1}, []float64{1, 5, 9}},
+		{"single bin", []float64{3, -1, 8}, 1, []int{3}, []float64{-1, 8}},
+		{"all values equal", []float64{5, 5, 5}, 2, []int{0, 3}, []float64{4.5, 5, 5.5}},
+		{"range overflows float64", []float64{-math.MaxFloat64, 0, math.MaxFloat64}, 2, []int{1, 2}, []float64{-math.MaxFloat64, 0, math.MaxFloat64}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			counts, edges, err := Histogram(tt.values, tt.bins)
+			if err != nil {
+				t.Fatalf("Histogram(%v, %d) returned error: %v", tt.values, tt.bins, err)
+			}
+			if !equalSlices(counts, tt.expectedCounts) {
+				t.Errorf("Histogram(%v, %d) counts = %v; want %v", tt.values, tt.bins, counts, tt.expectedCounts)
+			}
+			if !equalSlices(edges, tt.expectedEdges) {
+				t.Errorf("Histogram(%v, %d) edges = %v; want %v", tt.values, tt.bins, edges, tt.expectedEdges)
+			}
+		})
+	}
+
+	t.Run("wide finite range", func(t *testing.T) {
+		values := []float64{-1e308, -6e307, 1e307, 1e308}
+		counts, _, err := Histogram(values, 4)
+		if err != nil {
+			t.Fatalf("Histogram(%v, 4) returned error: %v", values, err)
+		}
+		if expected := []int{2, 0, 1, 1}; !equalSlices(counts, expected) {
+			t.Errorf("Histogram(%v, 4) counts = %v; want %v", values, counts, expected)
+		}
+	})
+
+	t.Run("counts sum to length", func(t *testing.T) {
+		r := NewRandom(42)
+		values := make([]int, 1000)
+		for i := range values {
+			values[i], _ = RandomInt(r, -50, 50)
+		}
+		counts, edges, err := Histogram(values, 7)
+		if err != nil {
+			t.Fatalf("Histogram(values, 7) returned error: %v", err)
+		}
+		if len(counts) != 7 || len(edges) != 8 {
+			t.Fatalf("Histogram(values, 7) returned %d counts and %d edges; want 7 and 8", len(counts), len(edges))
+		}
+		if total := Sum(counts); total != len(values) {
+			t.Errorf("Histogram(values, 7) counts sum to %d; want %d", total, len(values))
+		}
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		if _, _, err := Histogram([]int{}, 3); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Histogram([], 3) error = %v; want %v", err, ErrEmptyInput)
+		}
+		if _, _, err := Histogram([]int{1, 2}, 0); !errors.Is(err, ErrInvalidRange) {
+			t.Errorf("Histogram([1 2], 0) error = %v; want %v", err, ErrInvalidRange)
+		}
+		for _, values := range [][]float64{
+			{1, math.NaN(), 3},
+			{math.NaN()},
+			{1, math.Inf(1), 3},
+			{math.Inf(-1), 0},
+		} {
+			if _, _, err := Histogram(values, 2); !errors.Is(err, ErrInvalidRange) {
+				t.Errorf("Histogram(%v, 2) error = %v; want %v", values, err, ErrInvalidRange)
+			}
+		}
+	})
+}
+
+func TestVariance(t *testing.T) {
+	// Mean 5, sum of squared deviations 32.
+	data := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	tests := []struct {
+		name     string
+		values   []int
+		sample   bool
+		expected float64
+		err      error
+	}{
+		{"population", data, false, 4, nil},
+		{"sample", data, true, 32.0 / 7.0, nil},
+		{"constant values", []int{3, 3, 3}, true, 0, nil},
+		{"single element population", []int{5}, false, 0, nil},
+		{"single element sample", []int{5}, true, 0, ErrInsufficientData},
+		{"empty population", nil, false, 0, ErrEmptyInput},
+		{"empty sample", nil, true, 0, ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Variance(tt.values, tt.sample)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Variance(%v, %v) error = %v; want %v", tt.values, tt.sample, err, tt.err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("Variance(%v, %v) = %f; want %f", tt.values, tt.sample, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestStdDev(t *testing.T) {
+	data := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+
+	tests := []struct {
+		name     string
+		values   []float64
+		sample   bool
+		expected float64
+		err      error
+	}{
+		{"population", data, false, 2, nil},
+		{"sample", data, true, math.Sqrt(32.0 / 7.0), nil},
+		{"single element population", []float64{1.5}, false, 0, nil},
+		{"single element sample", []float64{1.5}, true, 0, ErrInsufficientData},
+		{"empty", nil, false, 0, ErrEmptyInput},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := StdDev(tt.values, tt.sample)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("StdDev(%v, %v) error = %v; want %v", tt.values, tt.sample, err, tt.err)
+			}
+			if math.Abs(result-tt.expected) > 1e-12 {
+				t.Errorf("StdDev(%v, %v) = %f; want %f", tt.values, tt.sample, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		expected []float64
+	}{
+		{"mixed range", []float64{10, 20, 15, 30}, []float64{0, 0.5, 0.25, 1}},
+		{"negative values", []float64{-2, 0, 2}, []float64{0, 0.5, 1}},
+		{"constant vector", []float64{4, 4, 4}, []float64{0, 0, 0}},
+		{"single value", []float64{7}, []float64{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Normalize(tt.values)
+			if err != nil {
+				t.Fatalf("Normalize(%v) returned error: %v", tt.values, err)
+			}
+			if !equalSlices(result, tt.expected) {
+				t.Errorf("Normalize(%v) = %v; want %v", tt.values, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("does not modify input", func(t *testing.T) {
+		values := []float64{3, 1, 2}
+		if _, err := Normalize(values); err != nil {
+			t.Fatalf("Normalize(%v) returned error: %v", values, err)
+		}
+		if expected := []float64{3, 1, 2}; !equalSlices(values, expected) {
+			t.Errorf("Normalize() mutated input: got %v; want %v", values, expected)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if _, err := Normalize([]float64{}); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Normalize([]) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
+
+func TestStandardize(t *testing.T) {
+	t.Run("mixed range", func(t *testing.T) {
+		values := []float64{2, 4, 4, 4, 5, 5, 7, 9}
+		result, err := Standardize(values)
+		if err != nil {
+			t.Fatalf("Standardize(%v) returned error: %v", values, err)
+		}
+		// Mean 5, population standard deviation 2.
+		expected := []float64{-1.5, -0.5, -0.5, -0.5, 0, 0, 1, 2}
+		if !equalSlices(result, expected) {
+			t.Errorf("Standardize(%v) = %v; want %v", values, result, expected)
+		}
+	})
+
+	t.Run("result has mean 0 and standard deviation 1", func(t *testing.T) {
+		values := []float64{1.5, -3, 8.25, 0, 4}
+		result, err := Standardize(values)
+		if err != nil {
+			t.Fatalf("Standardize(%v) returned error: %v", values, err)
+		}
+		mean, _ := Mean(result)
+		std, _ := StdDev(result, false)
+		if !FloatEqual(mean, 0, 1e-12) || !FloatEqual(std, 1, 1e-12) {
+			t.Errorf("Standardize(%v) has mean %v and std %v; want 0 and 1", values, mean, std)
+		}
+	})
+
+	t.Run("constant vector", func(t *testing.T) {
+		result, err := Standardize([]float64{3, 3})
+		if err != nil || !equalSlices(result, []float64{0, 0}) {
+			t.Errorf("Standardize([3 3]) = %v, %v; want [0 0], nil", result, err)
+		}
+	})
+
+	t.Run("empty slice", func(t *testing.T) {
+		if _, err := Standardize(nil); !errors.Is(err, ErrEmptyInput) {
+			t.Errorf("Standardize(nil) error = %v; want %v", err, ErrEmptyInput)
+		}
+	})
+}
+
+func TestMovingAverage(t *testing.T) {
+	series := []float64{1, 2, 3, 4, 5, 6}
+
+	tests := []struct {
+		name     string
+		values   []float64
+		window   int
+		expected []float64
+		err      error
+	}{
+		{"window of three", series, 3, []float64{2, 3, 4, 5}, nil},
+		{"window of two", []float64{10, 20, 0, 40}, 2, []float64{15, 10, 20}, nil},
+		{"window of one is identity", series, 1, series, nil},
+		{"window equals length", series, 6, []float64{3.5}, nil},
+		{"zero window", series, 0, nil, ErrInvalidRange},
+		{"negative window", series, -2, nil, ErrInvalidRange},
+		{"window larger than series", series, 7, nil, ErrInsufficientData},
+		{"empty series", []float64{}, 1, nil, ErrInsufficientData},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := MovingAverage(tt.values, tt.window)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("MovingAverage(%v, %d) error = %v; want %v", tt.values, tt.window, err, tt.err)
+			}
+			if tt.err == nil && !equalSlices(result, tt.expected) {
+				t.Errorf("MovingAverage(%v, %d) = %v; want %v", tt.values, tt.window, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("window of one returns a copy", func(t *testing.T) {
+		values := []float64{1, 2}
+		result, _ := MovingAverage(values, 1)
+		result[0] = 99
+		if values[0] != 1 {
+			t.Errorf("MovingAverage(values, 1) shares memory with values")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package mypackage
+
+import "time"
+
+// Stopwatch measures elapsed time across one or more Start/Stop intervals.
+// The zero value is a stopped Stopwatch with no elapsed time.
+// It is intended for use by a single goroutine.
+type Stopwatch struct {
+	start   time.Time
+	elapsed time.Duration
+	running bool
+}
+
+// Start starts or resumes the stopwatch. It has no effect if the
+// stopwatch is already running.
+func (s *Stopwatch) Start() {
+	if s.running {
+		return
+	}
+	s.start = time.Now()
+	s.running = true
+}
+
+// Stop stops the stopwatch and returns the total elapsed time.
+// Calling Stop on a stopped stopwatch returns the same total again.
+func (s *Stopwatch) Stop() time.Duration {
+	if s.running {
+		s.elapsed += time.Since(s.start)
+		s.running = false
+	}
+	return s.elapsed
+}
+
+// Elapsed returns the total elapsed time, including the current interval
+// if the stopwatch is running.
+func (s *Stopwatch) Elapsed() time.Duration {
+	if s.running {
+		return s.elapsed + time.Since(s.start)
+	}
+	return s.elapsed
+}
+
+// Reset stops the stopwatch and clears the elapsed time.
+func (s *Stopwatch) Reset() {
+	*s = Stopwatch{}
+}
+
+// Measure runs fn and returns how long it took.
+func Measure(fn func()) time.Duration {
+	start := time.Now()
+	fn()
+	return time.Since(start)
+}
+
+// MeasureErr runs fn and returns how long it took along with fn's error.
+func MeasureErr(fn func() error) (time.Duration, error) {
+	start := time.Now()
+	err := fn()
+	return time.Since(start), err
+}
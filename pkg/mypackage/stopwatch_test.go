@@ -0,0 +1,110 @@
+package mypackage
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStopwatch(t *testing.T) {
+	t.Run("elapsed grows while running", func(t *testing.T) {
+		var s Stopwatch
+		s.Start()
+		first := s.Elapsed()
+		DelaySimple(20 * time.Millisecond)
+		second := s.Elapsed()
+
+		if second <= first {
+			t.Errorf("Elapsed() did not grow: %v then %v", first, second)
+		}
+		if second < 20*time.Millisecond {
+			t.Errorf("Elapsed() = %v; want at least 20ms", second)
+		}
+	})
+
+	t.Run("stop returns a stable value", func(t *testing.T) {
+		var s Stopwatch
+		s.Start()
+		DelaySimple(10 * time.Millisecond)
+		stopped := s.Stop()
+		DelaySimple(10 * time.Millisecond)
+
+		if stopped < 10*time.Millisecond {
+			t.Errorf("Stop() = %v; want at least 10ms", stopped)
+		}
+		if again := s.Stop(); again != stopped {
+			t.Errorf("second Stop() = %v; want %v", again, stopped)
+		}
+		if elapsed := s.Elapsed(); elapsed != stopped {
+			t.Errorf("Elapsed() after Stop() = %v; want %v", elapsed, stopped)
+		}
+	})
+
+	t.Run("resumes accumulating", func(t *testing.T) {
+		var s Stopwatch
+		s.Start()
+		DelaySimple(10 * time.Millisecond)
+		first := s.Stop()
+		s.Start()
+		s.Start()
+		DelaySimple(10 * time.Millisecond)
+		total := s.Stop()
+
+		if total < first+10*time.Millisecond {
+			t.Errorf("Stop() after resume = %v; want at least %v", total, first+10*time.Millisecond)
+		}
+	})
+
+	t.Run("reset zeroes the state", func(t *testing.T) {
+		var s Stopwatch
+		s.Start()
+		DelaySimple(5 * time.Millisecond)
+		s.Reset()
+
+		if elapsed := s.Elapsed(); elapsed != 0 {
+			t.Errorf("Elapsed() after Reset() = %v; want 0", elapsed)
+		}
+		DelaySimple(5 * time.Millisecond)
+		if elapsed := s.Elapsed(); elapsed != 0 {
+			t.Errorf("Reset() left the stopwatch running: Elapsed() = %v", elapsed)
+		}
+	})
+}
+
+func TestMeasure(t *testing.T) {
+	d := Measure(func() {
+		DelaySimple(20 * time.Millisecond)
+	})
+	if d < 20*time.Millisecond {
+		t.Errorf("Measure() = %v; want at least 20ms", d)
+	}
+}
+
+func TestMeasureErr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		d, err := MeasureErr(func() error {
+			DelaySimple(20 * time.Millisecond)
+			return nil
+		})
+		if err != nil {
+			t.Errorf("MeasureErr() returned error: %v", err)
+		}
+		if d < 20*time.Millisecond {
+			t.Errorf("MeasureErr() = %v; want at least 20ms", d)
+		}
+	})
+
+	t.Run("returns fn's error", func(t *testing.T) {
+		errWork := errors.New("work failed")
+		d, err := MeasureErr(func() error {
+			DelaySimple(10 * time.Millisecond)
+			return errWork
+		})
+		if !errors.Is(err, errWork) {
+			t.Errorf("MeasureErr() error = %v; want %v", err, errWork)
+		}
+		if d < 10*time.Millisecond {
+			t.Errorf("MeasureErr() = %v; want at least 10ms", d)
+		}
+	})
+}
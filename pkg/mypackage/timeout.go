@@ -0,0 +1,56 @@
+package mypackage
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Timeout runs fn with a child context that is cancelled after d.
+// It returns fn's error if fn finishes first. Otherwise it returns
+// context.DeadlineExceeded once d elapses, or the parent's error if the
+// parent context is cancelled first.
+// fn should honor its context; if it does not, Timeout still returns on
+// time but fn keeps running in the background until it finishes.
+func Timeout(ctx context.Context, d time.Duration, fn func(context.Context) error) error {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	defer cancel()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- fn(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// ExecuteWithDeadline calls fn for each item in order, giving each call its
+// own Timeout of per derived from ctx, so no single item can use more than
+// per and the parent deadline still bounds the whole batch.
+// It stops at the first failure: if ctx is done it returns ctx.Err(),
+// otherwise an error that names the item's index and wraps fn's error, or
+// context.DeadlineExceeded if the item ran out of time. As with Timeout,
+// a call that ignores its context may still be running when
+// ExecuteWithDeadline returns.
+func ExecuteWithDeadline[T any](ctx context.Context, items []T, per time.Duration, fn func(context.Context, T) error) error {
+	for i, item := range items {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		err := Timeout(ctx, per, func(ctx context.Context) error {
+			return fn(ctx, item)
+		})
+		if err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return ctxErr
+			}
+			return fmt.Errorf("item %d: %w", i, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,166 @@
+package mypackage
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestTimeout(t *testing.T) {
+	t.Run("fast completion", func(t *testing.T) {
+		err := Timeout(context.Background(), time.Second, func(ctx context.Context) error {
+			return nil
+		})
+		if err != nil {
+			t.Errorf("Timeout() returned error: %v", err)
+		}
+	})
+
+	t.Run("timeout fires", func(t *testing.T) {
+		start := time.Now()
+		err := Timeout(context.Background(), 20*time.Millisecond, func(ctx context.Context) error {
+			return Delay(ctx, time.Second)
+		})
+		elapsed := time.Since(start)
+
+		if err != context.DeadlineExceeded {
+			t.Errorf("Timeout() should return context.DeadlineExceeded, got: %v", err)
+		}
+		if elapsed >= time.Second {
+			t.Errorf("Timeout() should have fired early, took: %v", elapsed)
+		}
+	})
+
+	t.Run("fn ignoring its context", func(t *testing.T) {
+		release := make(chan struct{})
+		defer close(release)
+
+		err := Timeout(context.Background(), 20*time.Millisecond, func(ctx context.Context) error {
+			<-release
+			return nil
+		})
+		if err != context.DeadlineExceeded {
+			t.Errorf("Timeout() should return context.DeadlineExceeded, got: %v", err)
+		}
+	})
+
+	t.Run("parent cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancel()
+		}()
+
+		err := Timeout(ctx, time.Second, func(ctx context.Context) error {
+			return Delay(ctx, time.Second)
+		})
+		if err != context.Canceled {
+			t.Errorf("Timeout() should return context.Canceled, got: %v", err)
+		}
+	})
+
+	t.Run("fn returns its own error", func(t *testing.T) {
+		errWork := errors.New("work failed")
+		err := Timeout(context.Background(), time.Second, func(ctx context.Context) error {
+			return errWork
+		})
+		if !errors.Is(err, errWork) {
+			t.Errorf("Timeout() should return fn's error, got: %v", err)
+		}
+	})
+}
+
+func TestExecuteWithDeadline(t *testing.T) {
+	t.Run("all items succeed within budget", func(t *testing.T) {
+		var seen []int
+		err := ExecuteWithDeadline(context.Background(), []int{1, 2, 3}, 50*time.Millisecond, func(ctx context.Context, item int) error {
+			seen = append(seen, item)
+			return Delay(ctx, 5*time.Millisecond)
+		})
+		if err != nil {
+			t.Fatalf("ExecuteWithDeadline() returned error: %v", err)
+		}
+		if expected := []int{1, 2, 3}; !equalSlices(seen, expected) {
+			t.Errorf("ExecuteWithDeadline() processed %v; want %v", seen, expected)
+		}
+	})
+
+	t.Run("each item gets its own deadline", func(t *testing.T) {
+		var deadlines []time.Time
+		err := ExecuteWithDeadline(context.Background(), []int{1, 2}, time.Second, func(ctx context.Context, item int) error {
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return errors.New("no deadline")
+			}
+			deadlines = append(deadlines, deadline)
+			return Delay(ctx, 10*time.Millisecond)
+		})
+		if err != nil {
+			t.Fatalf("ExecuteWithDeadline() returned error: %v", err)
+		}
+		if len(deadlines) != 2 || !deadlines[1].After(deadlines[0]) {
+			t.Errorf("ExecuteWithDeadline() deadlines = %v; want a fresh deadline per item", deadlines)
+		}
+	})
+
+	t.Run("one item times out", func(t *testing.T) {
+		// The timed-out call may still be returning when ExecuteWithDeadline
+		// does, so guard the shared slice.
+		var mu sync.Mutex
+		var seen []int
+		err := ExecuteWithDeadline(context.Background(), []int{1, 2, 3}, 20*time.Millisecond, func(ctx context.Context, item int) error {
+			mu.Lock()
+			seen = append(seen, item)
+			mu.Unlock()
+			if item == 2 {
+				return Delay(ctx, time.Second)
+			}
+			return nil
+		})
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Fatalf("ExecuteWithDeadline() error = %v; want %v", err, context.DeadlineExceeded)
+		}
+		if err.Error() != "item 1: context deadline exceeded" {
+			t.Errorf("ExecuteWithDeadline() error = %q; want it to name item 1", err)
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		if expected := []int{1, 2}; !equalSlices(seen, expected) {
+			t.Errorf("ExecuteWithDeadline() processed %v; want %v", seen, expected)
+		}
+	})
+
+	t.Run("item error", func(t *testing.T) {
+		err := ExecuteWithDeadline(context.Background(), []string{"a", "b"}, time.Second, func(ctx context.Context, item string) error {
+			return errFlaky
+		})
+		if !errors.Is(err, errFlaky) {
+			t.Errorf("ExecuteWithDeadline() error = %v; want %v", err, errFlaky)
+		}
+	})
+
+	t.Run("parent cancellation", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+		defer cancel()
+
+		var calls atomic.Int32
+		start := time.Now()
+		err := ExecuteWithDeadline(ctx, make([]int, 100), 20*time.Millisecond, func(ctx context.Context, item int) error {
+			calls.Add(1)
+			return Delay(ctx, 10*time.Millisecond)
+		})
+		if !errors.Is(err, context.DeadlineExceeded) || err.Error() != context.DeadlineExceeded.Error() {
+			t.Fatalf("ExecuteWithDeadline() error = %v; want the parent's %v", err, context.DeadlineExceeded)
+		}
+		if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+			t.Errorf("ExecuteWithDeadline() took %v; want it to stop near the parent deadline", elapsed)
+		}
+		if n := calls.Load(); n >= 100 {
+			t.Errorf("ExecuteWithDeadline() made %d calls; want it to stop early", n)
+		}
+	})
+}
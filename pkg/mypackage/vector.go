@@ -0,0 +1,51 @@
+package mypackage
+
+// DotProduct returns the dot product of two vectors.
+// The dot product of two empty vectors is zero.
+// It returns ErrLengthMismatch if a and b have different lengths.
+func DotProduct[T Number](a, b []T) (T, error) {
+	var sum T
+	if len(a) != len(b) {
+		return sum, ErrLengthMismatch
+	}
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum, nil
+}
+
+// VectorAdd returns the element-wise sum of two vectors as a new slice.
+// It returns ErrLengthMismatch if a and b have different lengths.
+func VectorAdd[T Number](a, b []T) ([]T, error) {
+	if len(a) != len(b) {
+		return nil, ErrLengthMismatch
+	}
+	result := make([]T, len(a))
+	for i := range a {
+		result[i] = a[i] + b[i]
+	}
+	return result, nil
+}
+
+// VectorSub returns the element-wise difference a - b as a new slice.
+// It returns ErrLengthMismatch if a and b have different lengths.
+func VectorSub[T Number](a, b []T) ([]T, error) {
+	if len(a) != len(b) {
+		return nil, ErrLengthMismatch
+	}
+	result := make([]T, len(a))
+	for i := range a {
+		result[i] = a[i] - b[i]
+	}
+	return result, nil
+}
+
+// ScalarMultiply returns a new slice with every element of vec multiplied
+// by scalar. The input slice is not modified.
+func ScalarMultiply[T Number](scalar T, vec []T) []T {
+	result := make([]T, len(vec))
+	for i, v := range vec {
+		result[i] = scalar * v
+	}
+	return result
+}
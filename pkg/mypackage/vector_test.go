@@ -0,0 +1,150 @@
+package mypackage
+
+import (
+	"errors"
+	"testing"
+)
+
+// equalSlices reports whether a and b have the same elements in order.
+func equalSlices[T comparable](a, b []T) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestDotProduct(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected int
+		err      error
+	}{
+		{"matching lengths", []int{1, 2, 3}, []int{4, 5, 6}, 32, nil},
+		{"orthogonal", []int{1, 0}, []int{0, 1}, 0, nil},
+		{"negative components", []int{-1, 2}, []int{3, -4}, -11, nil},
+		{"empty vectors", []int{}, []int{}, 0, nil},
+		{"nil vectors", nil, nil, 0, nil},
+		{"mismatched lengths", []int{1, 2}, []int{1}, 0, ErrLengthMismatch},
+		{"one empty", []int{}, []int{1}, 0, ErrLengthMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := DotProduct(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("DotProduct(%v, %v) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if result != tt.expected {
+				t.Errorf("DotProduct(%v, %v) = %d; want %d", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		result, err := DotProduct([]float64{0.5, 1.5}, []float64{2, 4})
+		if err != nil || result != 7 {
+			t.Errorf("DotProduct([0.5 1.5], [2 4]) = %f, %v; want 7, nil", result, err)
+		}
+	})
+}
+
+func TestVectorAdd(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected []int
+		err      error
+	}{
+		{"matching lengths", []int{1, 2, 3}, []int{4, 5, 6}, []int{5, 7, 9}, nil},
+		{"negative components", []int{-1, 2}, []int{1, -4}, []int{0, -2}, nil},
+		{"empty vectors", []int{}, []int{}, []int{}, nil},
+		{"mismatched lengths", []int{1, 2}, []int{1}, nil, ErrLengthMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := VectorAdd(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("VectorAdd(%v, %v) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if !equalSlices(result, tt.expected) {
+				t.Errorf("VectorAdd(%v, %v) = %v; want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+
+	t.Run("returns a new slice", func(t *testing.T) {
+		a := []int{1, 2}
+		result, _ := VectorAdd(a, []int{0, 0})
+		result[0] = 99
+		if a[0] != 1 {
+			t.Errorf("VectorAdd() result aliases its input")
+		}
+	})
+}
+
+func TestVectorSub(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []int
+		expected []int
+		err      error
+	}{
+		{"matching lengths", []int{5, 7, 9}, []int{4, 5, 6}, []int{1, 2, 3}, nil},
+		{"negative result", []int{1, 2}, []int{3, 5}, []int{-2, -3}, nil},
+		{"empty vectors", []int{}, []int{}, []int{}, nil},
+		{"mismatched lengths", []int{1}, []int{1, 2}, nil, ErrLengthMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := VectorSub(tt.a, tt.b)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("VectorSub(%v, %v) error = %v; want %v", tt.a, tt.b, err, tt.err)
+			}
+			if !equalSlices(result, tt.expected) {
+				t.Errorf("VectorSub(%v, %v) = %v; want %v", tt.a, tt.b, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestScalarMultiply(t *testing.T) {
+	tests := []struct {
+		name     string
+		scalar   int
+		vec      []int
+		expected []int
+	}{
+		{"positive scalar", 3, []int{1, -2, 4}, []int{3, -6, 12}},
+		{"negative scalar", -2, []int{1, -2, 4}, []int{-2, 4, -8}},
+		{"zero scalar", 0, []int{1, 2}, []int{0, 0}},
+		{"empty vector", 5, []int{}, []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			input := append([]int(nil), tt.vec...)
+			result := ScalarMultiply(tt.scalar, tt.vec)
+			if !equalSlices(result, tt.expected) {
+				t.Errorf("ScalarMultiply(%d, %v) = %v; want %v", tt.scalar, tt.vec, result, tt.expected)
+			}
+			if !equalSlices(tt.vec, input) {
+				t.Errorf("ScalarMultiply() mutated input: got %v; want %v", tt.vec, input)
+			}
+		})
+	}
+
+	t.Run("floats", func(t *testing.T) {
+		result := ScalarMultiply(0.5, []float64{2, 3})
+		if !equalSlices(result, []float64{1, 1.5}) {
+			t.Errorf("ScalarMultiply(0.5, [2 3]) = %v; want [1 1.5]", result)
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package mypackage
+
+import "fmt"
+
+// Build metadata, injected at build time with -ldflags, for example:
+//
+//	go build -ldflags "-X github.com/link-foundation/go-ai-driven-development-pipeline-template/pkg/mypackage.gitCommit=$(git rev-parse HEAD)"
+var (
+	gitCommit = "unknown"
+	buildDate = "unknown"
+)
+
+// VersionInfo describes the version and build of this package.
+type VersionInfo struct {
+	Version   string
+	GitCommit string
+	BuildDate string
+}
+
+// Build returns the version and build metadata of this package.
+// GitCommit and BuildDate are "unknown" unless injected via -ldflags.
+func Build() VersionInfo {
+	return VersionInfo{
+		Version:   Version,
+		GitCommit: gitCommit,
+		BuildDate: buildDate,
+	}
+}
+
+// String formats the version information for display,
+// such as "0.1.0 (commit abc1234, built 2024-12-27)".
+func (v VersionInfo) String() string {
+	return fmt.Sprintf("%s (commit %s, built %s)", v.Version, v.GitCommit, v.BuildDate)
+}
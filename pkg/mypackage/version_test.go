@@ -0,0 +1,32 @@
+package mypackage
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuild(t *testing.T) {
+	info := Build()
+
+	if info.Version != Version {
+		t.Errorf("Build().Version = %q; want %q", info.Version, Version)
+	}
+	if info.GitCommit == "" {
+		t.Error("Build().GitCommit should not be empty")
+	}
+	if info.BuildDate == "" {
+		t.Error("Build().BuildDate should not be empty")
+	}
+}
+
+func TestVersionInfoString(t *testing.T) {
+	info := VersionInfo{Version: "1.2.3", GitCommit: "abc1234", BuildDate: "2024-12-27"}
+	expected := "1.2.3 (commit abc1234, built 2024-12-27)"
+	if s := info.String(); s != expected {
+		t.Errorf("VersionInfo.String() = %q; want %q", s, expected)
+	}
+
+	if s := Build().String(); !strings.Contains(s, Version) {
+		t.Errorf("Build().String() = %q; want it to contain %q", s, Version)
+	}
+}